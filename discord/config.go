@@ -1,26 +1,123 @@
 package discord
 
 import (
+	"context"
+	"net/http"
+	"time"
+
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	authTypeBot    = "bot"
+	authTypeBearer = "bearer"
 )
 
 type Config struct {
 	Token    string
 	ClientID string
 	Secret   string
+	APIURL   string
+	ServerID string
+
+	AuditLogReason     string
+	DeletionProtection bool
+
+	AuthType string
+	Scopes   []string
+
+	MaxRetries             int
+	RetryMaxWait           time.Duration
+	RespectGlobalRateLimit bool
+
+	ProxyURL           string
+	CACertPEM          string
+	InsecureSkipVerify bool
+	RequestTimeout     time.Duration
 }
 
 type Context struct {
 	Session *discordgo.Session
 	Config  *Config
+	// HTTPClient fetches resources outside of the Discord API, such as
+	// remote images, with the provider's proxy and TLS settings.
+	HTTPClient *http.Client
+
+	cache *apiCache
 }
 
 func (c *Config) Client(version string) (*Context, error) {
 	session, err := discordgo.New(c.Token)
+	if err != nil {
+		return nil, err
+	}
 	session.UserAgent = "discord-terraform/" + version
+
+	base, err := newBaseTransport(c)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Context{Config: c, Session: session}, nil
+	timeout := session.Client.Timeout
+	if c.RequestTimeout > 0 {
+		timeout = c.RequestTimeout
+	}
+
+	var transport http.RoundTripper = base
+	if c.APIURL != "" {
+		transport = &apiURLTransport{base: c.APIURL, next: transport}
+	}
+
+	transport = &auditLogTransport{next: transport}
+
+	if c.AuthType == authTypeBearer {
+		// The bearer token replaces the bot token, so discordgo must not set
+		// an Authorization header of its own.
+		session.Token = ""
+		transport = &oauth2.Transport{
+			Source: c.tokenSource(&http.Client{Transport: transport, Timeout: timeout}),
+			Base:   transport,
+		}
+	}
+
+	// Retries are handled by retryTransport, so discordgo must not retry on
+	// its own. The client timeout moves to the transport so that it bounds
+	// each attempt instead of the whole retry loop.
+	cache := newAPICache()
+	session.Client.Transport = &cacheTransport{
+		cache: cache,
+		next: &retryTransport{
+			maxRetries:    c.MaxRetries,
+			maxWait:       c.RetryMaxWait,
+			respectGlobal: c.RespectGlobalRateLimit,
+			timeout:       timeout,
+			next:          transport,
+		},
+	}
+	session.Client.Timeout = 0
+	session.ShouldRetryOnRateLimit = false
+	session.MaxRestRetries = 0
+
+	return &Context{
+		Config:     c,
+		Session:    session,
+		HTTPClient: &http.Client{Transport: base, Timeout: timeout},
+		cache:      cache,
+	}, nil
+}
+
+// tokenSource exchanges the OAuth2 client credentials for a bearer token,
+// fetching a new one whenever the current token expires.
+func (c *Config) tokenSource(client *http.Client) oauth2.TokenSource {
+	config := &clientcredentials.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.Secret,
+		TokenURL:     discordgo.EndpointOAuth2 + "token",
+		Scopes:       c.Scopes,
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+
+	return config.TokenSource(context.WithValue(context.Background(), oauth2.HTTPClient, client))
 }
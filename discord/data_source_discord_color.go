@@ -2,33 +2,53 @@ package discord
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"gopkg.in/go-playground/colors.v1"
 )
 
-func dataSourceDiscordColor() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceDiscordColorRead,
+var _ datasource.DataSourceWithValidateConfig = &colorDataSource{}
+
+type colorDataSource struct{}
+
+type colorDataSourceModel struct {
+	ID  types.String `tfsdk:"id"`
+	Hex types.String `tfsdk:"hex"`
+	RGB types.String `tfsdk:"rgb"`
+	Dec types.Int64  `tfsdk:"dec"`
+}
+
+func newColorDataSource() datasource.DataSource {
+	return &colorDataSource{}
+}
+
+func (d *colorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_color"
+}
+
+func (d *colorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: "A simple helper to get the integer representation of a hex or RGB color.",
-		Schema: map[string]*schema.Schema{
-			"hex": {
-				ExactlyOneOf: []string{"hex", "rgb"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The hex color code. Either this or `rgb` is required.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this resource.",
 			},
-			"rgb": {
-				ExactlyOneOf: []string{"hex", "rgb"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The RGB color, in format: `rgb(R, G, B)`. Either this or `hex` is required.",
+			"hex": schema.StringAttribute{
+				Optional:    true,
+				Description: "The hex color code. Either this or `rgb` is required.",
 			},
-			"dec": {
-				Type:        schema.TypeInt,
+			"rgb": schema.StringAttribute{
+				Optional:    true,
+				Description: "The RGB color, in format: `rgb(R, G, B)`. Either this or `hex` is required.",
+			},
+			"dec": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The integer representation of the passed color.",
 			},
@@ -36,6 +56,18 @@ func dataSourceDiscordColor() *schema.Resource {
 	}
 }
 
+func (d *colorDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config colorDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Hex.IsUnknown() || config.RGB.IsUnknown() {
+		return
+	}
+
+	if config.Hex.IsNull() == config.RGB.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("hex"), "Invalid combination of arguments", "Exactly one of `hex` or `rgb` must be specified")
+	}
+}
+
 func ConvertToInt(hex string) (int64, error) {
 	hex = strings.Replace(hex, "0x", "", 1)
 	hex = strings.Replace(hex, "0X", "", 1)
@@ -44,31 +76,38 @@ func ConvertToInt(hex string) (int64, error) {
 	return strconv.ParseInt(hex, 16, 64)
 }
 
-func dataSourceDiscordColorRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (d *colorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data colorDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var hex string
-	if v, ok := d.GetOk("hex"); ok {
-		if clr, err := colors.ParseHEX(v.(string)); err != nil {
-			return diag.Errorf("Failed to parse hex %s: %s", v.(string), err.Error())
+	if v := data.Hex.ValueString(); v != "" {
+		if clr, err := colors.ParseHEX(v); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to parse hex %s", v), err.Error())
+			return
 		} else {
 			hex = clr.String()
 		}
 	}
-	if v, ok := d.GetOk("rgb"); ok {
-		if clr, err := colors.ParseRGB(v.(string)); err != nil {
-			return diag.Errorf("Failed to parse rgb %s: %s", v.(string), err.Error())
+	if v := data.RGB.ValueString(); v != "" {
+		if clr, err := colors.ParseRGB(v); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to parse rgb %s", v), err.Error())
+			return
 		} else {
 			hex = clr.ToHEX().String()
 		}
 	}
 
-	if intColor, err := ConvertToInt(hex); err != nil {
-		return diag.Errorf("Failed to parse hex %s: %s", hex, err.Error())
-	} else {
-		d.SetId(strconv.Itoa(int(intColor)))
-		d.Set("dec", int(intColor))
-
-		return diags
+	intColor, err := ConvertToInt(hex)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to parse hex %s", hex), err.Error())
+		return
 	}
+
+	data.ID = types.StringValue(strconv.Itoa(int(intColor)))
+	data.Dec = types.Int64Value(intColor)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
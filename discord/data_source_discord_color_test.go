@@ -1,6 +1,7 @@
 package discord
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -9,9 +10,13 @@ import (
 func TestAccDatasourceDiscordColor(t *testing.T) {
 	name := "data.discord_color.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccDatasourceDiscordColorBoth,
+				ExpectError: regexp.MustCompile("Exactly one of `hex` or `rgb` must be specified"),
+			},
 			{
 				Config: testAccDatasourceDiscordColorRGB,
 				Check: resource.ComposeTestCheckFunc(
@@ -41,3 +46,10 @@ data "discord_color" "example" {
   rgb = "rgb(3, 27, 49)"
 }
 `
+
+const testAccDatasourceDiscordColorBoth = `
+data "discord_color" "example" {
+  hex = "#031b31"
+  rgb = "rgb(3, 27, 49)"
+}
+`
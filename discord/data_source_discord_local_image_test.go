@@ -9,8 +9,8 @@ import (
 func TestAccDatasourceDiscordLocalImage(t *testing.T) {
 	name := "data.discord_local_image.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceDiscordLocalImage,
@@ -18,8 +18,8 @@ func TestAccDatasourceDiscordMember(t *testing.T) {
 
 	name := "data.discord_member.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceDiscordMemberUserID(testServerID, testUserID),
@@ -9,8 +9,8 @@ import (
 func TestAccDatasourceDiscordPermission(t *testing.T) {
 	name := "data.discord_permission.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceDiscordPermissionSimple,
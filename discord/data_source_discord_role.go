@@ -73,10 +73,9 @@ func dataSourceDiscordRoleRead(ctx context.Context, d *schema.ResourceData, m in
 	var diags diag.Diagnostics
 	var err error
 	var role *discordgo.Role
-	client := m.(*Context).Session
 
 	serverId := d.Get("server_id").(string)
-	server, err := client.Guild(serverId, discordgo.WithContext(ctx))
+	server, err := m.(*Context).getGuild(ctx, serverId)
 	if err != nil {
 		return diag.Errorf("Failed to fetch server %s: %s", serverId, err.Error())
 	}
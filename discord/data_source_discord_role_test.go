@@ -18,8 +18,8 @@ func TestAccDatasourceDiscordRole(t *testing.T) {
 
 	name := "data.discord_role.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceDiscordRoleID(testServerID, testRoleID),
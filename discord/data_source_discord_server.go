@@ -135,7 +135,7 @@ func dataSourceDiscordServerRead(ctx context.Context, d *schema.ResourceData, m
 	client := m.(*Context).Session
 
 	if v, ok := d.GetOk("server_id"); ok {
-		server, err = client.Guild(v.(string), discordgo.WithContext(ctx))
+		server, err = m.(*Context).getGuild(ctx, v.(string))
 		if err != nil {
 			return diag.Errorf("Failed to fetch server %s: %s", v.(string), err.Error())
 		}
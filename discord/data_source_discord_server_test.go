@@ -16,8 +16,8 @@ func TestAccDatasourceDiscordServer(t *testing.T) {
 
 	name := "data.discord_server.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceDiscordServer(testServerID),
@@ -29,7 +29,7 @@ func TestAccDatasourceDiscordServer(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "explicit_content_filter", "2"),
 					resource.TestCheckResourceAttr(name, "afk_timeout", "300"),
 					resource.TestCheckResourceAttrSet(name, "owner_id"),
-					resource.TestCheckResourceAttrSet(name, "roles"),
+					resource.TestCheckResourceAttrSet(name, "roles.#"),
 				),
 			},
 		},
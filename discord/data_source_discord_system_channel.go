@@ -36,10 +36,9 @@ func dataSourceDiscordSystemChannelRead(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	var err error
 	var server *discordgo.Guild
-	client := m.(*Context).Session
 
 	serverId := d.Get("server_id").(string)
-	if server, err = client.Guild(serverId, discordgo.WithContext(ctx)); err != nil {
+	if server, err = m.(*Context).getGuild(ctx, serverId); err != nil {
 		return diag.Errorf("Failed to fetch server %s: %s", serverId, err.Error())
 	} else {
 		d.SetId(serverId)
@@ -0,0 +1,61 @@
+package discord
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/lucky3028/discord-terraform/internal/fakediscord"
+)
+
+// TestMain runs the acceptance tests against an in-memory fake of the Discord
+// API when TF_ACC is set without a DISCORD_TOKEN, so they need neither a bot
+// nor network access.
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) == "" || os.Getenv("DISCORD_TOKEN") != "" {
+		os.Exit(m.Run())
+	}
+
+	api := fakediscord.NewServer()
+	for k, v := range seedFakeAPI(api) {
+		os.Setenv(k, v)
+	}
+
+	code := m.Run()
+	api.Close()
+	os.Exit(code)
+}
+
+// seedFakeAPI creates the server, channel, role and member the acceptance
+// tests expect to exist, and returns the environment variables pointing the
+// tests at them.
+func seedFakeAPI(api *fakediscord.Server) map[string]string {
+	serverID := api.AddGuild(fakediscord.Object{
+		"name":                          "Discord Terraform Test Server",
+		"default_message_notifications": 1,
+		"verification_level":            1,
+		"explicit_content_filter":       2,
+	})
+	channelID := api.AddChannel(serverID, fakediscord.Object{"name": "general"})
+	api.EditGuild(serverID, fakediscord.Object{"system_channel_id": channelID})
+
+	roleName := "terraform-test-role"
+	roleID := api.AddRole(serverID, fakediscord.Object{"name": roleName})
+	// Taken by the role the role tests move to position 2.
+	api.AddRole(serverID, fakediscord.Object{"name": "terraform-test-role-2"})
+
+	username := "terraform-test-user"
+	userID := api.AddMember(serverID, fakediscord.Object{"username": username, "avatar": "0123456789abcdef"}, []string{roleID})
+
+	return map[string]string{
+		"DISCORD_TOKEN":           "fake",
+		"DISCORD_API_URL":         api.URL,
+		"DISCORD_TEST_SERVER_ID":  serverID,
+		"DISCORD_TEST_CHANNEL_ID": channelID,
+		"DISCORD_TEST_ROLE_ID":    roleID,
+		"DISCORD_TEST_ROLE_NAME":  roleName,
+		"DISCORD_TEST_USER_ID":    userID,
+		"DISCORD_TEST_USERNAME":   username,
+		"DISCORD_TEST_AVATAR_URL": api.ImageURL,
+	}
+}
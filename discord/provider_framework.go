@@ -0,0 +1,186 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ProtoV5ProviderServerFactory returns the provider served to Terraform: the
+// SDKv2 provider muxed with the framework one. Resources and data sources are
+// moved to the framework provider one at a time, and each of them is served
+// by exactly one of the two.
+func ProtoV5ProviderServerFactory(ctx context.Context, version string) (func() tfprotov5.ProviderServer, error) {
+	client := &sharedClient{}
+	providers := []func() tfprotov5.ProviderServer{
+		providerWithClient(version, client)().GRPCProvider,
+		providerserver.NewProtocol5(&frameworkProvider{version: version, client: client}),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+// frameworkProvider is the terraform-plugin-framework half of the provider.
+// Its schema must match the SDKv2 provider's exactly, and the SDKv2 provider
+// validates the provider block for both of them.
+type frameworkProvider struct {
+	version string
+	client  *sharedClient
+}
+
+type frameworkProviderModel struct {
+	Token                  types.String `tfsdk:"token"`
+	AuthType               types.String `tfsdk:"auth_type"`
+	ClientID               types.String `tfsdk:"client_id"`
+	Secret                 types.String `tfsdk:"secret"`
+	OAuth2Scopes           types.List   `tfsdk:"oauth2_scopes"`
+	APIURL                 types.String `tfsdk:"api_url"`
+	ServerID               types.String `tfsdk:"server_id"`
+	AuditLogReason         types.String `tfsdk:"audit_log_reason"`
+	DeletionProtection     types.Bool   `tfsdk:"deletion_protection"`
+	MaxRetries             types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait           types.Int64  `tfsdk:"retry_max_wait"`
+	RespectGlobalRateLimit types.Bool   `tfsdk:"respect_global_rate_limit"`
+	ProxyURL               types.String `tfsdk:"proxy_url"`
+	CACertFile             types.String `tfsdk:"ca_cert_file"`
+	CACertPEM              types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify     types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout         types.Int64  `tfsdk:"request_timeout"`
+}
+
+func NewFrameworkProvider(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &frameworkProvider{version: version, client: &sharedClient{}}
+	}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "discord"
+	resp.Version = p.version
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	sdkSchema := Provider(p.version)().Schema
+	description := func(name string) string {
+		return sdkSchema[name].Description
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"token":                     schema.StringAttribute{Optional: true, Description: description("token")},
+			"auth_type":                 schema.StringAttribute{Optional: true, Description: description("auth_type")},
+			"client_id":                 schema.StringAttribute{Optional: true, Description: description("client_id")},
+			"secret":                    schema.StringAttribute{Optional: true, Sensitive: true, Description: description("secret")},
+			"oauth2_scopes":             schema.ListAttribute{Optional: true, ElementType: types.StringType, Description: description("oauth2_scopes")},
+			"api_url":                   schema.StringAttribute{Optional: true, Description: description("api_url")},
+			"server_id":                 schema.StringAttribute{Optional: true, Description: description("server_id")},
+			"audit_log_reason":          schema.StringAttribute{Optional: true, Description: description("audit_log_reason")},
+			"deletion_protection":       schema.BoolAttribute{Optional: true, Description: description("deletion_protection")},
+			"max_retries":               schema.Int64Attribute{Optional: true, Description: description("max_retries")},
+			"retry_max_wait":            schema.Int64Attribute{Optional: true, Description: description("retry_max_wait")},
+			"respect_global_rate_limit": schema.BoolAttribute{Optional: true, Description: description("respect_global_rate_limit")},
+			"proxy_url":                 schema.StringAttribute{Optional: true, Sensitive: true, Description: description("proxy_url")},
+			"ca_cert_file":              schema.StringAttribute{Optional: true, Description: description("ca_cert_file")},
+			"ca_cert_pem":               schema.StringAttribute{Optional: true, Description: description("ca_cert_pem")},
+			"insecure_skip_verify":      schema.BoolAttribute{Optional: true, Description: description("insecure_skip_verify")},
+			"request_timeout":           schema.Int64Attribute{Optional: true, Description: description("request_timeout")},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	if !data.OAuth2Scopes.IsNull() {
+		resp.Diagnostics.Append(data.OAuth2Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Null arguments take the defaults of the SDKv2 provider's schema.
+	config := Config{
+		Token:    data.Token.ValueString(),
+		ClientID: data.ClientID.ValueString(),
+		Secret:   data.Secret.ValueString(),
+		APIURL:   data.APIURL.ValueString(),
+		ServerID: data.ServerID.ValueString(),
+
+		AuditLogReason:     data.AuditLogReason.ValueString(),
+		DeletionProtection: data.DeletionProtection.ValueBool(),
+
+		AuthType: stringOrDefault(data.AuthType, authTypeBot),
+		Scopes:   scopes,
+
+		MaxRetries:             int(int64OrDefault(data.MaxRetries, defaultMaxRetries)),
+		RetryMaxWait:           time.Duration(int64OrDefault(data.RetryMaxWait, defaultRetryMaxWait)) * time.Second,
+		RespectGlobalRateLimit: data.RespectGlobalRateLimit.IsNull() || data.RespectGlobalRateLimit.ValueBool(),
+
+		ProxyURL:           data.ProxyURL.ValueString(),
+		CACertPEM:          data.CACertPEM.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		RequestTimeout:     time.Duration(int64OrDefault(data.RequestTimeout, defaultRequestTimeout)) * time.Second,
+	}
+
+	client, diags := p.client.get(config, data.CACertFile.ValueString(), p.version)
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			resp.Diagnostics.AddError(d.Summary, d.Detail)
+		} else {
+			resp.Diagnostics.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newColorDataSource,
+	}
+}
+
+func stringOrDefault(v types.String, def string) string {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+
+	return v.ValueString()
+}
+
+func int64OrDefault(v types.Int64, def int64) int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+
+	return v.ValueInt64()
+}
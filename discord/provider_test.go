@@ -1,18 +1,28 @@
 package discord
 
 import (
+	"context"
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-// providerFactories are used to instantiate a provider during acceptance testing.
+// protoV5ProviderFactories are used to instantiate a provider during acceptance testing.
 // The factory function will be invoked for every Terraform CLI command executed
 // to create a provider server to which the CLI can reattach.
-var providerFactories = map[string]func() (*schema.Provider, error){
-	"discord": func() (*schema.Provider, error) {
-		return Provider("dev")(), nil
+var protoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"discord": func() (tfprotov5.ProviderServer, error) {
+		providerServer, err := ProtoV5ProviderServerFactory(context.Background(), "dev")
+		if err != nil {
+			return nil, err
+		}
+
+		return providerServer(), nil
 	},
 }
 
@@ -22,6 +32,77 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderServerSchemas(t *testing.T) {
+	providerServer, err := ProtoV5ProviderServerFactory(context.Background(), "dev")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The mux server rejects SDKv2 and framework providers whose provider
+	// schemas differ, or that both serve the same resource or data source.
+	resp, err := providerServer().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	if _, ok := resp.DataSourceSchemas["discord_color"]; !ok {
+		t.Errorf("data sources Error: ex: %v, ac: %v", "discord_color", resp.DataSourceSchemas)
+	}
+}
+
+func TestProviderResourceTimeouts(t *testing.T) {
+	for name, r := range Provider("dev")().ResourcesMap {
+		if r.Timeouts == nil || r.Timeouts.Create == nil || r.Timeouts.Update == nil || r.Timeouts.Delete == nil {
+			t.Errorf("%s - timeouts Error: ex: %v, ac: %v", name, "create, update and delete", r.Timeouts)
+		}
+	}
+}
+
+func TestProviderServerIdForceNew(t *testing.T) {
+	// Nothing can be moved to another server, so changing server_id must
+	// replace the resource.
+	for name, r := range Provider("dev")().ResourcesMap {
+		if s, ok := r.Schema["server_id"]; ok && s.Optional && !s.ForceNew {
+			t.Errorf("%s - server_id ForceNew Error: ex: %v, ac: %v", name, true, s.ForceNew)
+		}
+	}
+}
+
+func TestProviderSharedClient(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("DISCORD_TOKEN", "token")
+
+	// Both halves of the muxed provider must use the same client, so that
+	// they share its rate limits and cache.
+	client := &sharedClient{}
+	sdkProvider := providerWithClient("dev", client)()
+	if diags := sdkProvider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	fwProvider := &frameworkProvider{version: "dev", client: client}
+	var schemaResp provider.SchemaResponse
+	fwProvider.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	var resp provider.ConfigureResponse
+	fwProvider.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, values)},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("err: %v", resp.Diagnostics)
+	}
+
+	if resp.ResourceData != sdkProvider.Meta() {
+		t.Errorf("client Error: ex: %p, ac: %p", sdkProvider.Meta(), resp.ResourceData)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
@@ -30,3 +111,14 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("DISCORD_TOKEN must be set for acceptance tests")
 	}
 }
+
+// testAccClient returns an API client configured like the provider under test,
+// for changing objects behind Terraform's back.
+func testAccClient(t *testing.T) *Context {
+	client, diags := newProviderClient(Config{AuthType: authTypeBot}, "", "test")
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	return client
+}
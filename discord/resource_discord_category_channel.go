@@ -1,6 +1,7 @@
 package discord
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,6 +11,7 @@ func resourceDiscordCategoryChannel() *schema.Resource {
 		ReadContext:   resourceChannelRead,
 		UpdateContext: resourceChannelUpdate,
 		DeleteContext: resourceChannelDelete,
+		CustomizeDiff: customdiff.All(resourceServerIdCustomizeDiff, resourceDeletionProtectionCustomizeDiff, resourcePermissionOverwriteCustomizeDiff),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -15,8 +15,8 @@ func TestAccResourceDiscordCategoryChannel(t *testing.T) {
 	}
 	name := "discord_category_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordSystemChannel(testServerID),
@@ -26,6 +26,7 @@ func TestAccResourceDiscordCategoryChannel(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "type", "category"),
 					resource.TestCheckResourceAttr(name, "position", "1"),
 					resource.TestCheckResourceAttrSet(name, "channel_id"),
+					resource.TestCheckNoResourceAttr(name, "category"),
 				),
 			},
 		},
@@ -7,6 +7,7 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/net/context"
@@ -14,10 +15,14 @@ import (
 
 func getChannelSchema(channelType string, s map[string]*schema.Schema) map[string]*schema.Schema {
 	addedSchema := map[string]*schema.Schema{
-		"server_id": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "ID of server this channel is in.",
+		"server_id":           serverIdSchema("ID of server this channel is in.", true),
+		"deletion_protection": deletionProtectionSchema("channel"),
+		"permission_overwrite": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Permission overwrites of the channel. When set, they replace every overwrite of the channel, including the ones added outside of Terraform, and are applied in the same request that creates or edits the channel. Removing every block stops managing the overwrites rather than removing them. Shouldn't be used together with `discord_channel_permission` or `discord_channel_permissions` on the same channel.",
+			Elem:        permissionOverwriteResource(),
 		},
 		"id": {
 			Type:        schema.TypeString,
@@ -32,9 +37,9 @@ func getChannelSchema(channelType string, s map[string]*schema.Schema) map[strin
 		"type": {
 			Type:        schema.TypeString,
 			Required:    true,
-			Description: "The type of the channel. This is only for internal use and should never be provided.",
+			Description: channelTypeDescription(channelType),
 			ValidateDiagFunc: func(i interface{}, path cty.Path) (diags diag.Diagnostics) {
-				if i.(string) != channelType {
+				if i.(string) != channelType && !(isConvertibleChannelType(channelType) && isConvertibleChannelType(i.(string))) {
 					diags = append(diags, diag.Errorf("type must be %s, %s passed", channelType, i.(string))...)
 				}
 
@@ -53,7 +58,16 @@ func getChannelSchema(channelType string, s map[string]*schema.Schema) map[strin
 			Type:        schema.TypeInt,
 			Default:     1,
 			Optional:    true,
-			Description: "Position of the channel, `0`-indexed.",
+			Description: "Position of the channel, `0`-indexed. Ignored when `after_channel_id` or `before_channel_id` is set.",
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				// Attributes removed from the configuration keep their state
+				// value while diffing, so they are read from the configuration.
+				config := d.GetRawConfig()
+				if config.IsNull() || !config.IsKnown() {
+					return false
+				}
+				return !config.GetAttr("after_channel_id").IsNull() || !config.GetAttr("before_channel_id").IsNull()
+			},
 			ValidateFunc: func(val interface{}, key string) (warns []string, errors []error) {
 				v := val.(int)
 
@@ -64,6 +78,23 @@ func getChannelSchema(channelType string, s map[string]*schema.Schema) map[strin
 				return
 			},
 		},
+		"after_channel_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"before_channel_id", "position"},
+			Description:   "ID of the channel this channel is listed right after, in the same category. Unlike `position`, it doesn't depend on how Discord numbers the channels.",
+		},
+		"before_channel_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"after_channel_id", "position"},
+			Description:   "ID of the channel this channel is listed right before, in the same category. Unlike `position`, it doesn't depend on how Discord numbers the channels.",
+		},
+		"position_in_category": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Where the channel is listed in its category, or among the categories for a category, `0`-indexed.",
+		},
 	}
 
 	if channelType != "category" {
@@ -76,7 +107,7 @@ func getChannelSchema(channelType string, s map[string]*schema.Schema) map[strin
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     true,
-			Description: "Whether channel permissions should be synced with the category this channel is in.",
+			Description: "Whether channel permissions should be synced with the category this channel is in. Has no effect on a channel without a category.",
 		}
 	}
 
@@ -87,6 +118,41 @@ func getChannelSchema(channelType string, s map[string]*schema.Schema) map[strin
 	return addedSchema
 }
 
+// isConvertibleChannelType reports whether channels of the type can be
+// converted into the other convertible type without recreating them. Discord
+// only converts text channels into news channels and back.
+func isConvertibleChannelType(channelType string) bool {
+	return channelType == "text" || channelType == "news"
+}
+
+func channelTypeDescription(channelType string) string {
+	if isConvertibleChannelType(channelType) {
+		return "The type of the channel, `text` or `news`. Changing it converts the channel in place, keeping its messages, pins and webhooks, which Discord only allows in servers with the Community feature. Defaults to `" + channelType + "`."
+	}
+
+	return "The type of the channel. This is only for internal use and should never be provided."
+}
+
+// resourcePermissionOverwriteCustomizeDiff rejects permission overwrites on
+// a channel that syncs its permissions with its category, as every apply
+// would replace one with the other.
+func resourcePermissionOverwriteCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute("sync_perms_with_category") {
+		return nil
+	}
+
+	overwrites := config.GetAttr("permission_overwrite")
+	if overwrites.IsNull() || !overwrites.IsKnown() || overwrites.LengthInt() == 0 {
+		return nil
+	}
+	if config.GetAttr("category").IsNull() || !d.Get("sync_perms_with_category").(bool) {
+		return nil
+	}
+
+	return errors.New("permission_overwrite can't be set on a channel synced with its category, set sync_perms_with_category to false")
+}
+
 func validateChannel(d *schema.ResourceData) (bool, error) {
 	channelType := d.Get("type").(string)
 
@@ -105,9 +171,6 @@ func validateChannel(d *schema.ResourceData) (bool, error) {
 			if _, ok := d.GetOk("topic"); ok {
 				return false, errors.New("topic is not allowed on voice channels")
 			}
-			if _, ok := d.GetOk("nsfw"); ok {
-				return false, errors.New("nsfw is not allowed on voice channels")
-			}
 		}
 	case "text", "news":
 		{
@@ -137,7 +200,10 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(reason)
 	}
 
-	serverId := d.Get("server_id").(string)
+	serverId, err := getServerId(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	channelType := d.Get("type").(string)
 	channelTypeInt, okay := getDiscordChannelType(channelType)
 	if !okay {
@@ -150,10 +216,11 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 		userlimit int
 		nsfw      bool
 		parentId  string
+		rtcRegion string
 	)
 
 	switch channelType {
-	case "text", "news":
+	case "text", "news", "forum", "media":
 		{
 			if v, ok := d.GetOk("topic"); ok {
 				topic = v.(string)
@@ -162,7 +229,7 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 				nsfw = v.(bool)
 			}
 		}
-	case "voice":
+	case "voice", "stage":
 		{
 			if v, ok := d.GetOk("bitrate"); ok {
 				bitrate = v.(int)
@@ -172,6 +239,12 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 			}
 		}
 	}
+	if channelType == "voice" || channelType == "stage" {
+		rtcRegion = d.Get("rtc_region").(string)
+	}
+	if channelType == "voice" {
+		nsfw = d.Get("nsfw").(bool)
+	}
 
 	isCategoryCh := channelType == "category"
 
@@ -180,17 +253,36 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 			parentId = v.(string)
 		}
 	}
-	channel, err := client.GuildChannelCreateComplex(serverId, discordgo.GuildChannelCreateData{
-		Name:      d.Get("name").(string),
-		Type:      channelTypeInt,
-		Topic:     topic,
-		Bitrate:   bitrate,
-		UserLimit: userlimit,
-		Position:  d.Get("position").(int),
-		ParentID:  parentId,
-		NSFW:      nsfw,
-	}, discordgo.WithContext(ctx))
+	data := channelCreateData{
+		GuildChannelCreateData: discordgo.GuildChannelCreateData{
+			Name:      d.Get("name").(string),
+			Type:      channelTypeInt,
+			Topic:     topic,
+			Bitrate:   bitrate,
+			UserLimit: userlimit,
+			Position:  d.Get("position").(int),
+			ParentID:  parentId,
+			NSFW:      nsfw,
+		},
+		RTCRegion: rtcRegion,
+	}
+	if channelType == "voice" {
+		data.RateLimitPerUser = d.Get("rate_limit_per_user").(int)
+		data.VideoQualityMode = d.Get("video_quality_mode").(int)
+	}
+	if channelType == "text" || channelType == "news" {
+		data.RateLimitPerUser = d.Get("rate_limit_per_user").(int)
+		data.DefaultAutoArchiveDuration = d.Get("default_auto_archive_duration").(int)
+		data.DefaultThreadRateLimitPerUser = d.Get("default_thread_rate_limit_per_user").(int)
+	}
+	if isForumChannelType(channelType) {
+		setForumCreateData(d, channelType, &data)
+	}
+	if v, ok := d.GetOk("permission_overwrite"); ok {
+		data.PermissionOverwrites = buildPermissionOverwrites(v.(*schema.Set))
+	}
 
+	channel, err := createChannel(ctx, client, serverId, data)
 	if err != nil {
 		return diag.Errorf("Failed to create channel: %s", err.Error())
 	}
@@ -198,18 +290,42 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 	d.SetId(channel.ID)
 	d.Set("server_id", serverId)
 	d.Set("channel_id", channel.ID)
+	d.Set("permission_overwrite", flattenPermissionOverwrites(channel.PermissionOverwrites))
+	if channelType == "text" || channelType == "news" {
+		d.Set("default_auto_archive_duration", channel.DefaultAutoArchiveDuration)
+	}
 
-	if !isCategoryCh {
-		if v, ok := d.GetOk("sync_perms_with_category"); ok && v.(bool) {
-			if channel.ParentID == "" {
-				return append(diags, diag.Errorf("Can't sync permissions with category. Channel (%s) doesn't have a category", channel.ID)...)
+	if isForumChannelType(channelType) {
+		// Channel flags can't be set when creating a channel.
+		if flags := forumChannelFlags(d, channelType, channel.Flags); flags != channel.Flags {
+			if channel, err = editChannel(ctx, client, channel.ID, channelEditData{ChannelEdit: discordgo.ChannelEdit{Flags: &flags}}); err != nil {
+				return diag.Errorf("Failed to update channel %s: %s", d.Id(), err.Error())
 			}
-			parent, err := client.Channel(channel.ParentID)
+		}
+
+		// Discord assigns the IDs of new tags.
+		d.Set("available_tag", flattenForumTags(channel.AvailableTags))
+	}
+
+	afterId, beforeId := d.Get("after_channel_id").(string), d.Get("before_channel_id").(string)
+	if afterId != "" || beforeId != "" {
+		if err := placeChannel(ctx, m.(*Context), serverId, channel.ID, afterId, beforeId); err != nil {
+			return diag.Errorf("Failed to place channel %s: %s", channel.ID, err.Error())
+		}
+	}
+	if diags := readChannelPlacement(ctx, d, m, channel); diags.HasError() {
+		return diags
+	}
+
+	if !isCategoryCh {
+		// A channel without a category has nothing to sync with.
+		if v, ok := d.GetOk("sync_perms_with_category"); ok && v.(bool) && channel.ParentID != "" {
+			parent, err := m.(*Context).getChannel(ctx, channel.GuildID, channel.ParentID)
 			if err != nil {
 				return append(diags, diag.Errorf("Can't sync permissions with category. Channel (%s) doesn't have a category", channel.ID)...)
 			}
 
-			if err = syncChannelPermissions(client, ctx, parent, channel); err != nil {
+			if err = syncChannelPermissions(client, ctx, &parent.Channel, &channel.Channel); err != nil {
 				return append(diags, diag.Errorf("Can't sync permissions with category: %s", channel.ID)...)
 			}
 		}
@@ -218,12 +334,51 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 	return diags
 }
 
+// readChannelPlacement sets where a channel is listed in its category. The
+// channel it is placed after or before only changes when another channel
+// took its place, so that Discord renumbering the channels isn't drift.
+func readChannelPlacement(ctx context.Context, d *schema.ResourceData, m interface{}, channel *apiChannel) diag.Diagnostics {
+	channels, err := m.(*Context).getGuildChannels(ctx, channel.GuildID)
+	if err != nil {
+		return diag.Errorf("Failed to fetch channels of server %s: %s", channel.GuildID, err.Error())
+	}
+
+	siblings := channelSiblings(channels, channel)
+	for i, sibling := range siblings {
+		if sibling.ID != channel.ID {
+			continue
+		}
+		d.Set("position_in_category", i)
+
+		if d.Get("after_channel_id").(string) != "" {
+			if i > 0 {
+				d.Set("after_channel_id", siblings[i-1].ID)
+			} else {
+				d.Set("after_channel_id", "")
+			}
+		}
+		if d.Get("before_channel_id").(string) != "" {
+			if i < len(siblings)-1 {
+				d.Set("before_channel_id", siblings[i+1].ID)
+			} else {
+				d.Set("before_channel_id", "")
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
 
-	channel, err := client.Channel(d.Id(), discordgo.WithContext(ctx))
+	channel, err := m.(*Context).getChannel(ctx, d.Get("server_id").(string), d.Id())
 	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Channel not found. Removing from state", map[string]interface{}{"channel_id": d.Id()})
+			d.SetId("")
+			return diags
+		}
 		return diag.Errorf("Failed to fetch channel %s: %s", d.Id(), err.Error())
 	}
 
@@ -233,44 +388,71 @@ func resourceChannelRead(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 
 	d.Set("server_id", channel.GuildID)
+	d.Set("channel_id", channel.ID)
 	d.Set("type", channelType)
 	d.Set("name", channel.Name)
 	d.Set("position", channel.Position)
+	d.Set("permission_overwrite", flattenPermissionOverwrites(channel.PermissionOverwrites))
 
 	switch channelType {
-	case "text", "news":
+	case "text", "news", "forum", "media":
 		{
 			d.Set("topic", channel.Topic)
 			d.Set("nsfw", channel.NSFW)
 		}
-	case "voice":
+	case "voice", "stage":
 		{
 			d.Set("bitrate", channel.Bitrate)
 			d.Set("user_limit", channel.UserLimit)
 		}
 	}
-
-	if channelType != "category" {
-		if channel.ParentID == "" {
-			d.Set("sync_perms_with_category", false)
+	if channelType == "text" || channelType == "news" {
+		d.Set("rate_limit_per_user", channel.RateLimitPerUser)
+		d.Set("default_auto_archive_duration", channel.DefaultAutoArchiveDuration)
+		d.Set("default_thread_rate_limit_per_user", channel.DefaultThreadRateLimitPerUser)
+	}
+	if isForumChannelType(channelType) {
+		readForumChannel(d, channelType, channel)
+	}
+	if channelType == "voice" || channelType == "stage" {
+		// A null region means Discord picks one automatically.
+		if channel.RTCRegion == nil {
+			d.Set("rtc_region", "")
 		} else {
-			parent, err := client.Channel(channel.ParentID)
-			if err != nil {
-				return diag.Errorf("Failed to fetch category of channel %s: %s", channel.ID, err.Error())
-			}
+			d.Set("rtc_region", *channel.RTCRegion)
+		}
+	}
+	if channelType == "voice" {
+		d.Set("nsfw", channel.NSFW)
+		d.Set("rate_limit_per_user", channel.RateLimitPerUser)
+		// Discord leaves the video quality mode out until one is chosen.
+		if channel.VideoQualityMode == 0 {
+			d.Set("video_quality_mode", videoQualityModeAuto)
+		} else {
+			d.Set("video_quality_mode", channel.VideoQualityMode)
+		}
+	}
 
-			synced := arePermissionsSynced(channel, parent)
-			d.Set("sync_perms_with_category", synced)
+	// Without a category, sync_perms_with_category is kept as configured.
+	if channelType != "category" && channel.ParentID != "" {
+		parent, err := m.(*Context).getChannel(ctx, channel.GuildID, channel.ParentID)
+		if err != nil {
+			return diag.Errorf("Failed to fetch category of channel %s: %s", channel.ID, err.Error())
 		}
+
+		synced := arePermissionsSynced(&channel.Channel, &parent.Channel)
+		d.Set("sync_perms_with_category", synced)
 	}
 
-	if channel.ParentID == "" {
-		d.Set("category", nil)
-	} else {
-		d.Set("category", channel.ParentID)
+	if channelType != "category" {
+		if channel.ParentID == "" {
+			d.Set("category", nil)
+		} else {
+			d.Set("category", channel.ParentID)
+		}
 	}
 
-	return diags
+	return readChannelPlacement(ctx, d, m, channel)
 }
 
 func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -280,7 +462,10 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(reason)
 	}
 
-	channel, _ := client.Channel(d.Id(), discordgo.WithContext(ctx))
+	channel, err := m.(*Context).getChannel(ctx, d.Get("server_id").(string), d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to fetch channel %s: %s", d.Id(), err.Error())
+	}
 	channelType := d.Get("type").(string)
 
 	var (
@@ -297,46 +482,107 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	position = map[bool]int{true: d.Get("position").(int), false: channel.Position}[d.HasChange("position")]
 
 	switch channelType {
-	case "text", "news":
+	case "text", "news", "forum", "media":
 		{
 			topic = map[bool]string{true: d.Get("topic").(string), false: channel.Topic}[d.HasChange("topic")]
 			nsfw = map[bool]bool{true: d.Get("nsfw").(bool), false: channel.NSFW}[d.HasChange("nsfw")]
 		}
-	case "voice":
+	case "voice", "stage":
 		{
 			bitRate = map[bool]int{true: d.Get("bitrate").(int), false: channel.Bitrate}[d.HasChange("bitrate")]
 			userLimit = map[bool]int{true: d.Get("user_limit").(int), false: channel.UserLimit}[d.HasChange("user_limit")]
 		}
 	}
+	if channelType == "voice" {
+		nsfw = map[bool]bool{true: d.Get("nsfw").(bool), false: channel.NSFW}[d.HasChange("nsfw")]
+	}
 
 	if channelType != "category" && d.HasChange("category") {
 		id := d.Get("category").(string)
 		parentId = map[bool]string{true: id, false: ""}[d.Get("category").(string) != ""]
 	}
-	channel, err := client.ChannelEditComplex(d.Id(), &discordgo.ChannelEdit{
-		Name:      name,
-		Position:  &position,
-		Topic:     topic,
-		NSFW:      &nsfw,
-		Bitrate:   bitRate,
-		UserLimit: userLimit,
-		ParentID:  parentId,
-	}, discordgo.WithContext(ctx))
+	edit := channelEditData{
+		ChannelEdit: discordgo.ChannelEdit{
+			Name:      name,
+			Position:  &position,
+			Topic:     topic,
+			NSFW:      &nsfw,
+			Bitrate:   bitRate,
+			UserLimit: userLimit,
+			ParentID:  parentId,
+		},
+	}
+	if (channelType == "voice" || channelType == "stage") && d.HasChange("rtc_region") {
+		edit.RTCRegion = nullableString(d.Get("rtc_region").(string))
+	}
+	if channelType == "voice" {
+		if d.HasChange("rate_limit_per_user") {
+			rateLimit := d.Get("rate_limit_per_user").(int)
+			edit.RateLimitPerUser = &rateLimit
+		}
+		if d.HasChange("video_quality_mode") {
+			edit.VideoQualityMode = d.Get("video_quality_mode").(int)
+		}
+	}
+	if channelType == "text" || channelType == "news" {
+		if d.HasChange("rate_limit_per_user") {
+			rateLimit := d.Get("rate_limit_per_user").(int)
+			edit.RateLimitPerUser = &rateLimit
+		}
+		if d.HasChange("default_auto_archive_duration") {
+			edit.DefaultAutoArchiveDuration = d.Get("default_auto_archive_duration").(int)
+		}
+		if d.HasChange("default_thread_rate_limit_per_user") {
+			rateLimit := d.Get("default_thread_rate_limit_per_user").(int)
+			edit.DefaultThreadRateLimitPerUser = &rateLimit
+		}
+	}
+	if isForumChannelType(channelType) {
+		setForumEditData(d, channelType, channel, &edit)
+	}
+	if d.HasChange("permission_overwrite") {
+		overwrites := buildPermissionOverwrites(d.Get("permission_overwrite").(*schema.Set))
+		edit.PermissionOverwrites = &overwrites
+	}
+	if d.HasChange("type") {
+		channelTypeInt, ok := getDiscordChannelType(channelType)
+		if !ok {
+			return diag.Errorf("Invalid channel type: %s", channelType)
+		}
+		edit.Type = &channelTypeInt
+	}
+
+	channel, err = editChannel(ctx, client, d.Id(), edit)
 	if err != nil {
 		return diag.Errorf("Failed to update channel %s: %s", d.Id(), err.Error())
 	}
+	d.Set("permission_overwrite", flattenPermissionOverwrites(channel.PermissionOverwrites))
+	if channelType == "text" || channelType == "news" {
+		d.Set("default_auto_archive_duration", channel.DefaultAutoArchiveDuration)
+	}
+	if isForumChannelType(channelType) {
+		d.Set("available_tag", flattenForumTags(channel.AvailableTags))
+	}
+
+	afterId, beforeId := d.Get("after_channel_id").(string), d.Get("before_channel_id").(string)
+	if (afterId != "" || beforeId != "") && d.HasChanges("after_channel_id", "before_channel_id", "category") {
+		if err := placeChannel(ctx, m.(*Context), channel.GuildID, channel.ID, afterId, beforeId); err != nil {
+			return diag.Errorf("Failed to place channel %s: %s", channel.ID, err.Error())
+		}
+	}
+	if diags := readChannelPlacement(ctx, d, m, channel); diags.HasError() {
+		return diags
+	}
 
 	if channelType != "category" {
-		if v, ok := d.GetOk("sync_perms_with_category"); ok && v.(bool) {
-			if channel.ParentID == "" {
-				return append(diags, diag.Errorf("Can't sync permissions with category. Channel (%s) doesn't have a category", channel.ID)...)
-			}
-			parent, err := client.Channel(channel.ParentID, discordgo.WithContext(ctx))
+		// A channel without a category has nothing to sync with.
+		if v, ok := d.GetOk("sync_perms_with_category"); ok && v.(bool) && channel.ParentID != "" {
+			parent, err := m.(*Context).getChannel(ctx, channel.GuildID, channel.ParentID)
 			if err != nil {
 				return append(diags, diag.Errorf("Can't sync permissions with category. Channel (%s) doesn't have a category", channel.ID)...)
 			}
 
-			if err = syncChannelPermissions(client, ctx, parent, channel); err != nil {
+			if err = syncChannelPermissions(client, ctx, &parent.Channel, &channel.Channel); err != nil {
 				return append(diags, diag.Errorf("Can't sync permissions with category: %s", channel.ID)...)
 			}
 		}
@@ -349,8 +595,12 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, m interf
 	var diags diag.Diagnostics
 	client := m.(*Context).Session
 
+	if diags := checkDeletionProtection(d, "channel"); diags.HasError() {
+		return diags
+	}
+
 	_, err := client.ChannelDelete(d.Id(), discordgo.WithContext(ctx))
-	if err != nil {
+	if err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to delete channel %s: %s", d.Id(), err.Error())
 	}
 
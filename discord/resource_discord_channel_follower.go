@@ -0,0 +1,111 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDiscordChannelFollower() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChannelFollowerCreate,
+		ReadContext:   resourceChannelFollowerRead,
+		DeleteContext: resourceChannelFollowerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Description: "A resource to follow a news channel into another channel, which receives the messages published in the news channel through a webhook. " +
+			"Destroying the resource deletes the webhook. Can be imported with the ID of the webhook.",
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the news channel to follow.",
+			},
+			"target_channel_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the channel receiving the messages published in the news channel.",
+			},
+			"webhook_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the webhook posting the messages in the target channel.",
+			},
+		},
+	}
+}
+
+// followerWebhook is a webhook created by following a news channel. Unlike
+// discordgo's Webhook, it has the channel the messages come from.
+type followerWebhook struct {
+	discordgo.Webhook
+	SourceChannel *discordgo.Channel `json:"source_channel"`
+}
+
+func fetchFollowerWebhook(ctx context.Context, client *discordgo.Session, webhookId string) (*followerWebhook, error) {
+	var webhook *followerWebhook
+	endpoint := discordgo.EndpointWebhook(webhookId)
+	if err := channelRequest(ctx, client, "GET", endpoint, endpoint, nil, &webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func resourceChannelFollowerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Context).Session
+
+	channelId := d.Get("channel_id").(string)
+	follow, err := client.ChannelNewsFollow(channelId, d.Get("target_channel_id").(string), discordgo.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("Failed to follow channel %s: %s", channelId, err.Error())
+	}
+
+	d.SetId(follow.WebhookID)
+
+	return resourceChannelFollowerRead(ctx, d, m)
+}
+
+func resourceChannelFollowerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	webhook, err := fetchFollowerWebhook(ctx, m.(*Context).Session, d.Id())
+	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Channel follower webhook not found. Removing from state", map[string]interface{}{"webhook_id": d.Id()})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("Failed to fetch webhook %s: %s", d.Id(), err.Error())
+	}
+	if webhook.Type != discordgo.WebhookTypeChannelFollower {
+		return diag.Errorf("Webhook %s doesn't follow a channel", d.Id())
+	}
+
+	// Keep the followed channel when Discord leaves it out of the webhook.
+	if webhook.SourceChannel != nil {
+		d.Set("channel_id", webhook.SourceChannel.ID)
+	}
+	d.Set("target_channel_id", webhook.ChannelID)
+	d.Set("webhook_id", webhook.ID)
+
+	return diags
+}
+
+func resourceChannelFollowerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*Context).Session
+
+	if err := client.WebhookDelete(d.Id(), discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
+		return diag.Errorf("Failed to delete webhook %s: %s", d.Id(), err.Error())
+	}
+
+	return diags
+}
@@ -0,0 +1,96 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDiscordChannelFollower(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_channel_follower.example"
+	var webhookID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordChannelFollower(testServerID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_news_channel.example", "id"),
+					resource.TestCheckResourceAttrPair(name, "target_channel_id", "discord_text_channel.example", "id"),
+					resource.TestCheckResourceAttrPair(name, "webhook_id", name, "id"),
+					func(s *terraform.State) error {
+						webhookID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Following is undone by deleting the webhook.
+				PreConfig: func() {
+					if err := testAccClient(t).Session.WebhookDelete(webhookID); err != nil {
+						t.Fatalf("Failed to delete webhook %s: %s", webhookID, err.Error())
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceDiscordChannelFollower(testServerID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "webhook_id"),
+					func(s *terraform.State) error {
+						webhookID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceDiscordChannelFollower(testServerID, false),
+				Check: func(s *terraform.State) error {
+					if _, err := testAccClient(t).Session.Webhook(webhookID); !isDiscordNotFound(err) {
+						return fmt.Errorf("webhook %s wasn't deleted: %v", webhookID, err)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordChannelFollower(serverID string, follow bool) string {
+	follower := ""
+	if follow {
+		follower = `
+	resource "discord_channel_follower" "example" {
+	  channel_id = discord_news_channel.example.id
+	  target_channel_id = discord_text_channel.example.id
+	}`
+	}
+
+	return fmt.Sprintf(`
+	resource "discord_news_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-followed"
+	  sync_perms_with_category = false
+	}
+
+	resource "discord_text_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-follower"
+	  sync_perms_with_category = false
+	}
+	%[2]s`, serverID, follower)
+}
@@ -0,0 +1,234 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDiscordChannelOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChannelOrderCreate,
+		ReadContext:   resourceChannelOrderRead,
+		UpdateContext: resourceChannelOrderUpdate,
+		DeleteContext: resourceChannelOrderDelete,
+		CustomizeDiff: resourceServerIdCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Description: "A resource to order the categories of a server and the channels in them, in a single request. " +
+			"Only the listed categories and channels are ordered, and moving a listed channel to another category, or reordering them, shows up as drift. " +
+			"The resources of the ordered categories should ignore changes to their `position`, and the ones of the ordered channels to their `position` and `category`. " +
+			"Destroying the resource leaves the channels as they are. Can be imported with the server ID, which imports the current order of every channel.",
+		Schema: map[string]*schema.Schema{
+			"server_id": serverIdSchema("ID of the server.", true),
+			"category": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Categories of the server, from top to bottom.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the category. Leave it unset in a single block to order the channels outside any category, which Discord shows above every category.",
+						},
+						"channel_ids": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the channels in the category, from top to bottom. Discord always shows voice and stage channels below the other channels of a category.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildChannelPositions returns the positions of the categories and channels
+// of a server in the configured order.
+func buildChannelPositions(d *schema.ResourceData) ([]channelPosition, error) {
+	var (
+		positions     []channelPosition
+		categoryIndex int
+		uncategorized bool
+		seen          = map[string]bool{}
+	)
+
+	for _, c := range d.Get("category").([]interface{}) {
+		category, _ := c.(map[string]interface{})
+		if category == nil {
+			category = map[string]interface{}{"category_id": "", "channel_ids": []interface{}{}}
+		}
+
+		categoryId := category["category_id"].(string)
+		if categoryId == "" {
+			if uncategorized {
+				return nil, fmt.Errorf("only one category block can leave category_id unset")
+			}
+			uncategorized = true
+		} else {
+			if seen[categoryId] {
+				return nil, fmt.Errorf("category %s is listed more than once", categoryId)
+			}
+			seen[categoryId] = true
+			positions = append(positions, channelPosition{ID: categoryId, Position: categoryIndex})
+			categoryIndex++
+		}
+
+		for i, id := range category["channel_ids"].([]interface{}) {
+			channelId := id.(string)
+			if seen[channelId] {
+				return nil, fmt.Errorf("channel %s is listed more than once", channelId)
+			}
+			seen[channelId] = true
+			positions = append(positions, channelPosition{ID: channelId, Position: i, ParentID: nullableString(categoryId)})
+		}
+	}
+
+	return positions, nil
+}
+
+// flattenChannelOrder returns the live order of the categories and channels
+// in state, or of every category and channel of the server when the state
+// has none, as after an import.
+func flattenChannelOrder(current []interface{}, channels []*apiChannel) []map[string]interface{} {
+	sortChannelsByPosition(channels)
+
+	byId := map[string]*apiChannel{}
+	children := map[string][]string{}
+	for _, channel := range channels {
+		byId[channel.ID] = channel
+		if channel.Type != discordgo.ChannelTypeGuildCategory {
+			children[channel.ParentID] = append(children[channel.ParentID], channel.ID)
+		}
+	}
+
+	if len(current) == 0 {
+		var order []map[string]interface{}
+		if len(children[""]) > 0 {
+			order = append(order, map[string]interface{}{"category_id": "", "channel_ids": children[""]})
+		}
+		for _, channel := range channels {
+			if channel.Type == discordgo.ChannelTypeGuildCategory {
+				order = append(order, map[string]interface{}{"category_id": channel.ID, "channel_ids": children[channel.ID]})
+			}
+		}
+		return order
+	}
+
+	managed := map[string]bool{}
+	var (
+		categories    []*apiChannel
+		uncategorized = -1
+	)
+	for i, c := range current {
+		category, _ := c.(map[string]interface{})
+		if category == nil {
+			continue
+		}
+		for _, id := range category["channel_ids"].([]interface{}) {
+			managed[id.(string)] = true
+		}
+
+		categoryId := category["category_id"].(string)
+		if categoryId == "" {
+			uncategorized = i
+		} else if channel, ok := byId[categoryId]; ok && channel.Type == discordgo.ChannelTypeGuildCategory {
+			categories = append(categories, channel)
+		}
+	}
+
+	managedChildren := func(categoryId string) []string {
+		ids := []string{}
+		for _, id := range children[categoryId] {
+			if managed[id] {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	// The block of channels outside any category keeps its place, as it isn't
+	// ordered among the categories.
+	order := make([]map[string]interface{}, 0, len(categories)+1)
+	for _, category := range categories {
+		if len(order) == uncategorized {
+			order = append(order, map[string]interface{}{"category_id": "", "channel_ids": managedChildren("")})
+		}
+		order = append(order, map[string]interface{}{"category_id": category.ID, "channel_ids": managedChildren(category.ID)})
+	}
+	if uncategorized >= len(order) {
+		order = append(order, map[string]interface{}{"category_id": "", "channel_ids": managedChildren("")})
+	}
+
+	return order
+}
+
+func resourceChannelOrderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverId, err := getServerId(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := applyChannelOrder(ctx, d, m, serverId); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(serverId)
+	d.Set("server_id", serverId)
+
+	return resourceChannelOrderRead(ctx, d, m)
+}
+
+func applyChannelOrder(ctx context.Context, d *schema.ResourceData, m interface{}, serverId string) diag.Diagnostics {
+	positions, err := buildChannelPositions(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := reorderChannels(ctx, m.(*Context).Session, serverId, positions); err != nil {
+		return diag.Errorf("Failed to reorder channels of server %s: %s", serverId, err.Error())
+	}
+
+	return nil
+}
+
+func resourceChannelOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	channels, err := m.(*Context).getGuildChannels(ctx, d.Id())
+	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Server not found. Removing channel order from state", map[string]interface{}{"server_id": d.Id()})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("Failed to fetch channels of server %s: %s", d.Id(), err.Error())
+	}
+
+	d.Set("server_id", d.Id())
+	d.Set("category", flattenChannelOrder(d.Get("category").([]interface{}), channels))
+
+	return diags
+}
+
+func resourceChannelOrderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := applyChannelOrder(ctx, d, m, d.Id()); diags.HasError() {
+		return diags
+	}
+
+	return resourceChannelOrderRead(ctx, d, m)
+}
+
+func resourceChannelOrderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Channels always have an order, so there is nothing to undo.
+	return nil
+}
@@ -0,0 +1,209 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDiscordChannelOrder(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_channel_order.example"
+	var firstID, voiceID, categoryID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordChannelOrder(testServerID, `
+	  category {
+	    category_id = discord_category_channel.a.id
+	    channel_ids = [discord_text_channel.second.id, discord_text_channel.first.id, discord_voice_channel.voice.id]
+	  }
+	  category {
+	    category_id = discord_category_channel.b.id
+	    channel_ids = [discord_text_channel.third.id]
+	  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", testServerID),
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "category.#", "2"),
+					resource.TestCheckResourceAttrPair(name, "category.0.category_id", "discord_category_channel.a", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.0", "discord_text_channel.second", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.1", "discord_text_channel.first", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.2", "discord_voice_channel.voice", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.1.category_id", "discord_category_channel.b", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.1.channel_ids.0", "discord_text_channel.third", "id"),
+					testAccCheckChannelParent(t, "discord_text_channel.third", "discord_category_channel.b"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["discord_text_channel.first"].Primary.ID
+						voiceID = s.RootModule().Resources["discord_voice_channel.voice"].Primary.ID
+						categoryID = s.RootModule().Resources["discord_category_channel.b"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Discord lists voice channels below the other channels of a
+				// category whatever their position, so moving one above them
+				// isn't drift.
+				PreConfig: func() {
+					position := 0
+					if _, err := testAccClient(t).Session.ChannelEdit(voiceID, &discordgo.ChannelEdit{Position: &position}); err != nil {
+						t.Fatalf("Failed to move channel %s: %s", voiceID, err.Error())
+					}
+				},
+				Config: testAccResourceDiscordChannelOrder(testServerID, `
+	  category {
+	    category_id = discord_category_channel.a.id
+	    channel_ids = [discord_text_channel.second.id, discord_text_channel.first.id, discord_voice_channel.voice.id]
+	  }
+	  category {
+	    category_id = discord_category_channel.b.id
+	    channel_ids = [discord_text_channel.third.id]
+	  }`),
+				PlanOnly: true,
+			},
+			{
+				// Channels moved outside of Terraform show up as drift.
+				PreConfig: func() {
+					if _, err := testAccClient(t).Session.ChannelEdit(firstID, &discordgo.ChannelEdit{ParentID: categoryID}); err != nil {
+						t.Fatalf("Failed to move channel %s: %s", firstID, err.Error())
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "category.0.channel_ids.#", "2"),
+					resource.TestCheckResourceAttr(name, "category.1.channel_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordChannelOrder(testServerID, `
+	  category {
+	    category_id = discord_category_channel.b.id
+	    channel_ids = [discord_text_channel.third.id, discord_text_channel.first.id]
+	  }
+	  category {
+	    category_id = discord_category_channel.a.id
+	    channel_ids = [discord_text_channel.second.id, discord_voice_channel.voice.id]
+	  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "category.0.category_id", "discord_category_channel.b", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.0", "discord_text_channel.third", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.1", "discord_text_channel.first", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.1.category_id", "discord_category_channel.a", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.1.channel_ids.0", "discord_text_channel.second", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.1.channel_ids.1", "discord_voice_channel.voice", "id"),
+					testAccCheckChannelParent(t, "discord_text_channel.first", "discord_category_channel.b"),
+				),
+			},
+			{
+				// The import reports every channel of the server, which other
+				// tests add to concurrently.
+				ResourceName:  name,
+				ImportState:   true,
+				ImportStateId: testServerID,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+					if states[0].Attributes["server_id"] != testServerID {
+						return fmt.Errorf("expected server_id %s, got %s", testServerID, states[0].Attributes["server_id"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// testAccCheckChannelParent checks the channel is in the category.
+func testAccCheckChannelParent(t *testing.T, channel string, category string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		channelID := s.RootModule().Resources[channel].Primary.ID
+		categoryID := s.RootModule().Resources[category].Primary.ID
+
+		ch, err := testAccClient(t).Session.Channel(channelID)
+		if err != nil {
+			return err
+		}
+		if ch.ParentID != categoryID {
+			return fmt.Errorf("channel %s is in %s, expected %s", channelID, ch.ParentID, categoryID)
+		}
+		return nil
+	}
+}
+
+func testAccResourceDiscordChannelOrder(serverID string, categories string) string {
+	return fmt.Sprintf(`
+	resource "discord_category_channel" "a" {
+	  server_id = "%[1]s"
+	  name = "terraform-order-a"
+
+	  lifecycle {
+	    ignore_changes = [position]
+	  }
+	}
+
+	resource "discord_category_channel" "b" {
+	  server_id = "%[1]s"
+	  name = "terraform-order-b"
+
+	  lifecycle {
+	    ignore_changes = [position]
+	  }
+	}
+
+	resource "discord_text_channel" "first" {
+	  server_id = "%[1]s"
+	  name = "terraform-order-first"
+	  category = discord_category_channel.a.id
+
+	  lifecycle {
+	    ignore_changes = [position, category]
+	  }
+	}
+
+	resource "discord_text_channel" "second" {
+	  server_id = "%[1]s"
+	  name = "terraform-order-second"
+	  category = discord_category_channel.a.id
+
+	  lifecycle {
+	    ignore_changes = [position, category]
+	  }
+	}
+
+	resource "discord_text_channel" "third" {
+	  server_id = "%[1]s"
+	  name = "terraform-order-third"
+	  category = discord_category_channel.a.id
+
+	  lifecycle {
+	    ignore_changes = [position, category]
+	  }
+	}
+
+	resource "discord_voice_channel" "voice" {
+	  server_id = "%[1]s"
+	  name = "terraform-order-voice"
+	  category = discord_category_channel.a.id
+
+	  lifecycle {
+	    ignore_changes = [position, category]
+	  }
+	}
+
+	resource "discord_channel_order" "example" {
+	  server_id = "%[1]s"
+	  %[2]s
+	}`, serverID, categories)
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -91,8 +92,6 @@ func resourceChannelPermissionRead(ctx context.Context, d *schema.ResourceData,
 		permissionType discordgo.PermissionOverwriteType
 	)
 
-	client := m.(*Context).Session
-
 	cId, oId, pt, err := parseThreeIds(d.Id())
 	if err != nil {
 		log.Default().Printf("Unable to parse IDs out of the resource ID. Falling back on legacy config behavior.")
@@ -109,8 +108,13 @@ func resourceChannelPermissionRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("type", pt)
 	}
 
-	channel, err := client.Channel(channelId, discordgo.WithContext(ctx))
+	channel, err := m.(*Context).getChannel(ctx, "", channelId)
 	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Channel not found. Removing permission overwrite from state", map[string]interface{}{"channel_id": channelId, "overwrite_id": overwriteId})
+			d.SetId("")
+			return diags
+		}
 		return diag.Errorf("Failed to find channel %s: %s", channelId, err.Error())
 	}
 
@@ -118,10 +122,13 @@ func resourceChannelPermissionRead(ctx context.Context, d *schema.ResourceData,
 		if uint(x.Type) == uint(permissionType) && x.ID == overwriteId {
 			d.Set("allow", int(x.Allow))
 			d.Set("deny", int(x.Deny))
-			break
+			return diags
 		}
 	}
 
+	tflog.Warn(ctx, "Permission overwrite not found. Removing from state", map[string]interface{}{"channel_id": channelId, "overwrite_id": overwriteId})
+	d.SetId("")
+
 	return diags
 }
 
@@ -158,7 +165,7 @@ func resourceChannelPermissionDelete(ctx context.Context, d *schema.ResourceData
 	channelId := d.Get("channel_id").(string)
 	overwriteId := d.Get("overwrite_id").(string)
 
-	if err := client.ChannelPermissionDelete(channelId, overwriteId, discordgo.WithContext(ctx)); err != nil {
+	if err := client.ChannelPermissionDelete(channelId, overwriteId, discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to delete channel permissions %s: %s", channelId, err.Error())
 	} else {
 		return diags
@@ -17,8 +17,8 @@ func TestAccResourceDiscordChannelPermission(t *testing.T) {
 	}
 	name := "discord_channel_permission.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordChannelPermission(testServerID, testChannelID, testRoleID),
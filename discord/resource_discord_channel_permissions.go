@@ -0,0 +1,94 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDiscordChannelPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChannelPermissionsCreate,
+		ReadContext:   resourceChannelPermissionsRead,
+		UpdateContext: resourceChannelPermissionsUpdate,
+		DeleteContext: resourceChannelPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Description: "A resource to manage every permission overwrite of a channel. Overwrites that aren't configured, including the ones added outside of Terraform, are removed. Destroying the resource leaves the overwrites of the channel as they are, so a private channel doesn't become visible to everyone. Shouldn't be used together with `discord_channel_permission` or `permission_overwrite` blocks on the same channel, or on a channel synced with its category.",
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the channel.",
+			},
+			"permission_overwrite": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Permission overwrites of the channel. A channel without any block has no overwrites.",
+				Elem:        permissionOverwriteResource(),
+			},
+		},
+	}
+}
+
+// setChannelPermissions replaces every permission overwrite of a channel.
+func setChannelPermissions(ctx context.Context, client *discordgo.Session, channelId string, overwrites []*discordgo.PermissionOverwrite) error {
+	_, err := editChannel(ctx, client, channelId, channelEditData{PermissionOverwrites: &overwrites})
+	return err
+}
+
+func resourceChannelPermissionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Context).Session
+
+	channelId := d.Get("channel_id").(string)
+	overwrites := buildPermissionOverwrites(d.Get("permission_overwrite").(*schema.Set))
+	if err := setChannelPermissions(ctx, client, channelId, overwrites); err != nil {
+		return diag.Errorf("Failed to update channel permissions %s: %s", channelId, err.Error())
+	}
+
+	d.SetId(channelId)
+
+	return resourceChannelPermissionsRead(ctx, d, m)
+}
+
+func resourceChannelPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	channel, err := m.(*Context).getChannel(ctx, "", d.Id())
+	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Channel not found. Removing permission overwrites from state", map[string]interface{}{"channel_id": d.Id()})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("Failed to find channel %s: %s", d.Id(), err.Error())
+	}
+
+	d.Set("channel_id", channel.ID)
+	d.Set("permission_overwrite", flattenPermissionOverwrites(channel.PermissionOverwrites))
+
+	return diags
+}
+
+func resourceChannelPermissionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Context).Session
+
+	overwrites := buildPermissionOverwrites(d.Get("permission_overwrite").(*schema.Set))
+	if err := setChannelPermissions(ctx, client, d.Id(), overwrites); err != nil {
+		return diag.Errorf("Failed to update channel permissions %s: %s", d.Id(), err.Error())
+	}
+
+	return resourceChannelPermissionsRead(ctx, d, m)
+}
+
+func resourceChannelPermissionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Removing every overwrite would make a private channel public, so the
+	// overwrites are left as they are.
+	return nil
+}
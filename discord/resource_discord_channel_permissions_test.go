@@ -0,0 +1,137 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDiscordChannelPermissions(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testRoleID := os.Getenv("DISCORD_TEST_ROLE_ID")
+	testUserID := os.Getenv("DISCORD_TEST_USER_ID")
+	if testServerID == "" || testRoleID == "" || testUserID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID, DISCORD_TEST_ROLE_ID, and DISCORD_TEST_USER_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_channel_permissions.example"
+	var channelID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordChannelPermissions(testServerID, fmt.Sprintf(`
+	  permission_overwrite {
+	    type = "role"
+	    overwrite_id = "%[1]s"
+	    deny = 1024
+	  }
+	  permission_overwrite {
+	    type = "role"
+	    overwrite_id = "%[2]s"
+	    allow = 1024
+	  }`, testServerID, testRoleID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_text_channel.example", "id"),
+					resource.TestCheckResourceAttr(name, "permission_overwrite.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "permission_overwrite.*", map[string]string{
+						"type":         "role",
+						"overwrite_id": testRoleID,
+						"allow":        "1024",
+						"deny":         "0",
+					}),
+					func(s *terraform.State) error {
+						channelID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Overwrites added outside of Terraform show up as drift.
+				PreConfig: func() {
+					if err := testAccClient(t).Session.ChannelPermissionSet(channelID, testUserID, discordgo.PermissionOverwriteTypeMember, 2048, 0); err != nil {
+						t.Fatalf("err: %s", err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "permission_overwrite.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "permission_overwrite.*", map[string]string{
+						"type":         "user",
+						"overwrite_id": testUserID,
+						"allow":        "2048",
+					}),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Applying removes the overwrites that aren't configured.
+				Config: testAccResourceDiscordChannelPermissions(testServerID, fmt.Sprintf(`
+	  permission_overwrite {
+	    type = "user"
+	    overwrite_id = "%[1]s"
+	    allow = 2048
+	  }`, testUserID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "permission_overwrite.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "permission_overwrite.*", map[string]string{
+						"type":         "user",
+						"overwrite_id": testUserID,
+					}),
+				),
+			},
+			{
+				// Destroying the resource leaves the overwrites of the channel.
+				Config: testAccResourceDiscordChannelPermissionsChannel(testServerID),
+				Check: func(s *terraform.State) error {
+					channel, err := testAccClient(t).Session.Channel(channelID)
+					if err != nil {
+						return err
+					}
+					if len(channel.PermissionOverwrites) != 1 || channel.PermissionOverwrites[0].ID != testUserID {
+						return fmt.Errorf("expected the overwrite of %s to be left on channel %s, got %v", testUserID, channelID, channel.PermissionOverwrites)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccResourceDiscordChannelPermissions(testServerID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "permission_overwrite.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordChannelPermissions(serverID string, overwrites string) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-channel-permissions"
+	  sync_perms_with_category = false
+	}
+
+	resource "discord_channel_permissions" "example" {
+	  channel_id = discord_text_channel.example.id
+	  %[2]s
+	}`, serverID, overwrites)
+}
+
+func testAccResourceDiscordChannelPermissionsChannel(serverID string) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-channel-permissions"
+	  sync_perms_with_category = false
+	}`, serverID)
+}
@@ -1,31 +1,46 @@
 package discord
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceDiscordForumChannel() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"topic": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Topic of the channel.",
+		},
+		"nsfw": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the channel is NSFW.",
+		},
+		"default_forum_layout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 2),
+			Description:  "Default layout of posts in the channel. 0 = Not Set, 1 = List View, 2 = Gallery View.",
+		},
+	}
+	for k, v := range forumChannelSchema() {
+		s[k] = v
+	}
+
 	return &schema.Resource{
 		CreateContext: resourceChannelCreate,
 		ReadContext:   resourceChannelRead,
 		UpdateContext: resourceChannelUpdate,
 		DeleteContext: resourceChannelDelete,
+		CustomizeDiff: customdiff.All(resourceServerIdCustomizeDiff, resourceDeletionProtectionCustomizeDiff, resourcePermissionOverwriteCustomizeDiff),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Description: "A resource to create a forum channel.",
-		Schema: getChannelSchema("forum", map[string]*schema.Schema{
-			"topic": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Topic of the channel.",
-			},
-			"nsfw": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Whether the channel is NSFW.",
-			},
-		}),
+		Schema:      getChannelSchema("forum", s),
 	}
 }
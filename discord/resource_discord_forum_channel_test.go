@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceDiscordForumChannel(t *testing.T) {
@@ -15,8 +16,8 @@ func TestAccResourceDiscordForumChannel(t *testing.T) {
 	}
 	name := "discord_forum_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordForumChannel(testServerID),
@@ -29,12 +30,82 @@ func TestAccResourceDiscordForumChannel(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "topic", "Testing forum channel"),
 					resource.TestCheckResourceAttr(name, "nsfw", "false"),
 					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "false"),
+					resource.TestCheckResourceAttr(name, "available_tag.#", "0"),
+					resource.TestCheckResourceAttr(name, "require_tag", "false"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccResourceDiscordForumChannelSettings(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_forum_channel.example"
+	var questionID, bugID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordForumChannelSettings(testServerID, "question", 1, 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "available_tag.#", "2"),
+					resource.TestCheckResourceAttr(name, "available_tag.0.name", "question"),
+					resource.TestCheckResourceAttr(name, "available_tag.0.emoji_name", "❓"),
+					resource.TestCheckResourceAttr(name, "available_tag.1.name", "bug"),
+					resource.TestCheckResourceAttr(name, "available_tag.1.moderated", "true"),
+					resource.TestCheckResourceAttr(name, "default_reaction_emoji.#", "1"),
+					resource.TestCheckResourceAttr(name, "default_reaction_emoji.0.emoji_name", "✅"),
+					resource.TestCheckResourceAttr(name, "default_sort_order", "1"),
+					resource.TestCheckResourceAttr(name, "default_forum_layout", "2"),
+					resource.TestCheckResourceAttr(name, "default_thread_rate_limit_per_user", "60"),
+					resource.TestCheckResourceAttr(name, "require_tag", "true"),
+					testAccCheckForumTagID(name, 0, &questionID),
+					testAccCheckForumTagID(name, 1, &bugID),
+				),
+			},
+			{
+				Config: testAccResourceDiscordForumChannelSettings(testServerID, "help", 0, 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "available_tag.0.name", "help"),
+					resource.TestCheckResourceAttr(name, "default_sort_order", "0"),
+					resource.TestCheckResourceAttr(name, "default_forum_layout", "1"),
+					resource.TestCheckResourceAttr(name, "require_tag", "false"),
+					// Renaming a tag keeps its ID, so posts keep it.
+					testAccCheckForumTagID(name, 0, &questionID),
+					testAccCheckForumTagID(name, 1, &bugID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "sync_perms_with_category"},
+			},
+		},
+	})
+}
+
+// testAccCheckForumTagID records the ID of a forum tag, or checks it is the
+// recorded one.
+func testAccCheckForumTagID(name string, index int, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ac := s.RootModule().Resources[name].Primary.Attributes[fmt.Sprintf("available_tag.%d.id", index)]
+		if ac == "" {
+			return fmt.Errorf("tag %d has no ID", index)
+		}
+		if *id == "" {
+			*id = ac
+		} else if *id != ac {
+			return fmt.Errorf("tag %d ID Error: ex: %v, ac: %v", index, *id, ac)
+		}
+		return nil
+	}
+}
+
 func testAccResourceDiscordForumChannel(serverID string) string {
 	return fmt.Sprintf(`
 	resource "discord_forum_channel" "example" {
@@ -46,3 +117,29 @@ func testAccResourceDiscordForumChannel(serverID string) string {
       sync_perms_with_category = false
 	}`, serverID)
 }
+
+func testAccResourceDiscordForumChannelSettings(serverID string, tag string, sortOrder int, layout int, requireTag bool) string {
+	return fmt.Sprintf(`
+	resource "discord_forum_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-forum-settings"
+	  sync_perms_with_category = false
+	  default_sort_order = %[3]d
+	  default_forum_layout = %[4]d
+	  default_thread_rate_limit_per_user = 60
+	  require_tag = %[5]t
+
+	  available_tag {
+	    name = "%[2]s"
+	    emoji_name = "❓"
+	  }
+	  available_tag {
+	    name = "bug"
+	    moderated = true
+	  }
+
+	  default_reaction_emoji {
+	    emoji_name = "✅"
+	  }
+	}`, serverID, tag, sortOrder, layout, requireTag)
+}
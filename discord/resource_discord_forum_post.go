@@ -0,0 +1,257 @@
+package discord
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDiscordForumPost() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceForumPostCreate,
+		ReadContext:   resourceForumPostRead,
+		UpdateContext: resourceForumPostUpdate,
+		DeleteContext: resourceForumPostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceThreadImport,
+		},
+
+		Description: "A resource to create a post in a forum or media channel. Can be imported with `channel_id:post_id`.",
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the forum or media channel the post is in.",
+			},
+			"server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the server the post is in.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "Title of the post.",
+			},
+			"content": {
+				AtLeastOneOf: []string{"content", "embed"},
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Text content of the starter message. At least one of `content` or `embed` must be set.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == strings.TrimSuffix(new, "\r\n")
+				},
+			},
+			"embed": {
+				AtLeastOneOf: []string{"content", "embed"},
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "An embed block of the starter message. At least one of `content` or `embed` must be set.",
+				Elem:         embedSchema(),
+			},
+			"applied_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MaxItems:    5,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the tags of the channel applied to the post.",
+			},
+			"pinned": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the post is pinned to the top of the channel. A channel has at most one pinned post.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether only members with the Manage Threads permission can unarchive the post.",
+			},
+			"message_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the starter message of the post, which is the ID of the post itself.",
+			},
+		},
+	}
+}
+
+func buildForumPostMessage(d *schema.ResourceData) (*discordgo.MessageSend, error) {
+	message := &discordgo.MessageSend{
+		Content: d.Get("content").(string),
+		Embeds:  make([]*discordgo.MessageEmbed, 0, 1),
+	}
+	if v, ok := d.GetOk("embed"); ok {
+		embed, err := buildEmbed(v.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		message.Embeds = append(message.Embeds, embed)
+	}
+
+	return message, nil
+}
+
+func resourceForumPostCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Context).Session
+
+	channelId := d.Get("channel_id").(string)
+	message, err := buildForumPostMessage(d)
+	if err != nil {
+		return diag.Errorf("Failed to create post in %s: %s", channelId, err.Error())
+	}
+
+	post, err := client.ForumThreadStartComplex(channelId, &discordgo.ThreadStart{
+		Name:        d.Get("name").(string),
+		AppliedTags: buildAppliedTags(d),
+	}, message, discordgo.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("Failed to create post in %s: %s", channelId, err.Error())
+	}
+
+	d.SetId(post.ID)
+
+	// Posts can't be pinned or locked when they are created.
+	edit := &discordgo.ChannelEdit{}
+	if d.Get("pinned").(bool) {
+		flags := post.Flags | discordgo.ChannelFlagPinned
+		edit.Flags = &flags
+	}
+	if d.Get("locked").(bool) {
+		locked := true
+		edit.Locked = &locked
+	}
+	if edit.Flags != nil || edit.Locked != nil {
+		if _, err := client.ChannelEdit(post.ID, edit, discordgo.WithContext(ctx)); err != nil {
+			return diag.Errorf("Failed to update post %s: %s", post.ID, err.Error())
+		}
+	}
+
+	return resourceForumPostRead(ctx, d, m)
+}
+
+func resourceForumPostRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*Context).Session
+
+	post, err := m.(*Context).getChannel(ctx, d.Get("server_id").(string), d.Id())
+	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Forum post not found. Removing from state", map[string]interface{}{"post_id": d.Id()})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("Failed to fetch post %s: %s", d.Id(), err.Error())
+	}
+	if post.Type != discordgo.ChannelTypeGuildPublicThread || post.ThreadMetadata == nil {
+		return diag.Errorf("Channel %s is not a forum post", d.Id())
+	}
+
+	d.Set("channel_id", post.ParentID)
+	d.Set("server_id", post.GuildID)
+	d.Set("name", post.Name)
+	d.Set("applied_tags", post.AppliedTags)
+	d.Set("pinned", post.Flags&discordgo.ChannelFlagPinned != 0)
+	d.Set("locked", post.ThreadMetadata.Locked)
+	d.Set("message_id", post.ID)
+
+	message, err := client.ChannelMessage(post.ID, post.ID, discordgo.WithContext(ctx))
+	if err != nil {
+		if !isDiscordNotFound(err) {
+			return diag.Errorf("Failed to fetch starter message of post %s: %s", d.Id(), err.Error())
+		}
+		// The post stays without a starter message; it can't be given a new one.
+		tflog.Warn(ctx, "Starter message of forum post not found", map[string]interface{}{"post_id": d.Id()})
+		d.Set("content", "")
+		d.Set("embed", nil)
+		return diags
+	}
+
+	d.Set("content", message.Content)
+	if len(message.Embeds) > 0 {
+		d.Set("embed", unbuildEmbed(message.Embeds[0]))
+	} else {
+		d.Set("embed", nil)
+	}
+
+	return diags
+}
+
+func resourceForumPostUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Context).Session
+
+	post, err := m.(*Context).getChannel(ctx, d.Get("server_id").(string), d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to fetch post %s: %s", d.Id(), err.Error())
+	}
+
+	// Posts are archived after a while without activity, and an archived
+	// post can only be edited after unarchiving it.
+	archived := post.ThreadMetadata != nil && post.ThreadMetadata.Archived
+	edit := &discordgo.ChannelEdit{}
+	if d.HasChange("name") {
+		edit.Name = d.Get("name").(string)
+	}
+	if d.HasChange("applied_tags") {
+		tags := buildAppliedTags(d)
+		edit.AppliedTags = &tags
+	}
+	if d.HasChange("pinned") {
+		flags := post.Flags &^ discordgo.ChannelFlagPinned
+		if d.Get("pinned").(bool) {
+			flags |= discordgo.ChannelFlagPinned
+		}
+		edit.Flags = &flags
+	}
+	if d.HasChange("locked") {
+		locked := d.Get("locked").(bool)
+		edit.Locked = &locked
+	}
+	if archived && d.HasChanges("name", "applied_tags", "pinned", "locked", "content", "embed") {
+		unarchived := false
+		edit.Archived = &unarchived
+	}
+
+	if d.HasChanges("name", "applied_tags", "pinned", "locked") || edit.Archived != nil {
+		if _, err := client.ChannelEdit(d.Id(), edit, discordgo.WithContext(ctx)); err != nil {
+			return diag.Errorf("Failed to update post %s: %s", d.Id(), err.Error())
+		}
+	}
+
+	if d.HasChanges("content", "embed") {
+		message, err := buildForumPostMessage(d)
+		if err != nil {
+			return diag.Errorf("Failed to edit starter message of post %s: %s", d.Id(), err.Error())
+		}
+		if _, err := client.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:      d.Id(),
+			Channel: d.Id(),
+			Content: &message.Content,
+			Embeds:  &message.Embeds,
+		}, discordgo.WithContext(ctx)); err != nil {
+			return diag.Errorf("Failed to edit starter message of post %s: %s", d.Id(), err.Error())
+		}
+	}
+
+	return resourceForumPostRead(ctx, d, m)
+}
+
+func resourceForumPostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*Context).Session
+
+	if _, err := client.ChannelDelete(d.Id(), discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
+		return diag.Errorf("Failed to delete post %s: %s", d.Id(), err.Error())
+	}
+
+	return diags
+}
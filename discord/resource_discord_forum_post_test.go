@@ -0,0 +1,115 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDiscordForumPost(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_forum_post.example"
+	var postID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordForumPost(testServerID, "How do I get started?", 0, "pinned = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_forum_channel.example", "id"),
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "FAQ"),
+					resource.TestCheckResourceAttr(name, "content", "How do I get started?"),
+					resource.TestCheckResourceAttr(name, "embed.0.title", "Getting started"),
+					resource.TestCheckResourceAttr(name, "applied_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(name, "applied_tags.*", "discord_forum_channel.example", "available_tag.0.id"),
+					resource.TestCheckResourceAttr(name, "pinned", "true"),
+					resource.TestCheckResourceAttr(name, "locked", "false"),
+					resource.TestCheckResourceAttrPair(name, "message_id", name, "id"),
+					testAccCheckForumPostID(name, &postID),
+				),
+			},
+			{
+				// Editing the starter message keeps the post.
+				Config: testAccResourceDiscordForumPost(testServerID, "Read the wiki first.", 1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "content", "Read the wiki first."),
+					resource.TestCheckTypeSetElemAttrPair(name, "applied_tags.*", "discord_forum_channel.example", "available_tag.1.id"),
+					resource.TestCheckResourceAttr(name, "pinned", "false"),
+					testAccCheckForumPostID(name, &postID),
+				),
+			},
+			{
+				// Posts are archived after a while without activity.
+				PreConfig: func() {
+					archived := true
+					if _, err := testAccClient(t).Session.ChannelEdit(postID, &discordgo.ChannelEdit{Archived: &archived}); err != nil {
+						t.Fatalf("Failed to archive post %s: %s", postID, err.Error())
+					}
+				},
+				Config: testAccResourceDiscordForumPost(testServerID, "Read the wiki and the pins first.", 1, "locked = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "content", "Read the wiki and the pins first."),
+					resource.TestCheckResourceAttr(name, "locked", "true"),
+					testAccCheckForumPostID(name, &postID),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccThreadImportStateId(name),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckForumPostID records the ID of a forum post, or checks it is the
+// recorded one.
+func testAccCheckForumPostID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ac := s.RootModule().Resources[name].Primary.ID
+		if *id == "" {
+			*id = ac
+		} else if *id != ac {
+			return fmt.Errorf("post ID Error: ex: %v, ac: %v", *id, ac)
+		}
+		return nil
+	}
+}
+
+func testAccResourceDiscordForumPost(serverID string, content string, tag int, extra string) string {
+	return fmt.Sprintf(`
+	resource "discord_forum_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-forum-posts"
+	  sync_perms_with_category = false
+
+	  available_tag {
+	    name = "question"
+	  }
+	  available_tag {
+	    name = "answered"
+	  }
+	}
+
+	resource "discord_forum_post" "example" {
+	  channel_id = discord_forum_channel.example.id
+	  name = "FAQ"
+	  content = "%[2]s"
+	  applied_tags = [discord_forum_channel.example.available_tag[%[3]d].id]
+	  %[4]s
+
+	  embed {
+	    title = "Getting started"
+	  }
+	}`, serverID, content, tag, extra)
+}
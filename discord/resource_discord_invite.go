@@ -2,6 +2,7 @@ package discord
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/net/context"
@@ -89,6 +90,10 @@ func resourceInviteRead(ctx context.Context, d *schema.ResourceData, m interface
 	client := m.(*Context).Session
 
 	if invite, err := client.Invite(d.Id(), discordgo.WithContext(ctx)); err != nil {
+		if !isDiscordNotFound(err) {
+			return diag.Errorf("Failed to fetch invite %s: %s", d.Id(), err.Error())
+		}
+		tflog.Warn(ctx, "Invite not found. Removing from state", map[string]interface{}{"code": d.Id()})
 		d.SetId("")
 	} else {
 		d.Set("code", invite.Code)
@@ -101,7 +106,7 @@ func resourceInviteDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	var diags diag.Diagnostics
 	client := m.(*Context).Session
 
-	if _, err := client.InviteDelete(d.Id(), discordgo.WithContext(ctx)); err != nil {
+	if _, err := client.InviteDelete(d.Id(), discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
 		return diag.FromErr(err)
 	} else {
 		return diags
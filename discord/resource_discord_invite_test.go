@@ -15,8 +15,8 @@ func TestAccResourceDiscordInvite(t *testing.T) {
 	}
 	name := "discord_invite.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordInvite(testChannelID),
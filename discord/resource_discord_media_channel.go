@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDiscordMediaChannel() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"topic": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Topic of the channel. Discord shows it as the channel's guidelines; the API has no separate guidelines field.",
+		},
+		"nsfw": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the channel is NSFW.",
+		},
+		"hide_media_download_options": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to hide the download options of media in the channel.",
+		},
+	}
+	for k, v := range forumChannelSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceChannelCreate,
+		ReadContext:   resourceChannelRead,
+		UpdateContext: resourceChannelUpdate,
+		DeleteContext: resourceChannelDelete,
+		CustomizeDiff: customdiff.All(resourceServerIdCustomizeDiff, resourceDeletionProtectionCustomizeDiff, resourcePermissionOverwriteCustomizeDiff),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "A resource to create a media channel.",
+		Schema:      getChannelSchema("media", s),
+	}
+}
@@ -0,0 +1,91 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDiscordMediaChannel(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_media_channel.example"
+	var tagID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordMediaChannel(testServerID, "art", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-media-channel"),
+					resource.TestCheckResourceAttr(name, "type", "media"),
+					resource.TestCheckResourceAttr(name, "topic", "Post your own work only"),
+					resource.TestCheckResourceAttr(name, "hide_media_download_options", "true"),
+					resource.TestCheckResourceAttr(name, "available_tag.#", "2"),
+					resource.TestCheckResourceAttr(name, "available_tag.0.name", "art"),
+					resource.TestCheckResourceAttr(name, "available_tag.0.emoji_name", "🎨"),
+					resource.TestCheckResourceAttr(name, "available_tag.1.name", "wip"),
+					resource.TestCheckResourceAttr(name, "available_tag.1.moderated", "true"),
+					resource.TestCheckResourceAttr(name, "default_reaction_emoji.0.emoji_name", "👍"),
+					func(s *terraform.State) error {
+						tagID = s.RootModule().Resources[name].Primary.Attributes["available_tag.0.id"]
+						if tagID == "" {
+							return fmt.Errorf("tag has no ID")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceDiscordMediaChannel(testServerID, "illustration", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hide_media_download_options", "false"),
+					resource.TestCheckResourceAttr(name, "available_tag.0.name", "illustration"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[name].Primary.Attributes["available_tag.0.id"]; id != tagID {
+							return fmt.Errorf("renamed tag ID Error: ex: %v, ac: %v", tagID, id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "sync_perms_with_category"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordMediaChannel(serverID string, tag string, hide bool) string {
+	return fmt.Sprintf(`
+	resource "discord_media_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-media-channel"
+	  topic = "Post your own work only"
+	  hide_media_download_options = %[3]t
+	  sync_perms_with_category = false
+
+	  available_tag {
+	    name = "%[2]s"
+	    emoji_name = "🎨"
+	  }
+	  available_tag {
+	    name = "wip"
+	    moderated = true
+	  }
+
+	  default_reaction_emoji {
+	    emoji_name = "👍"
+	  }
+	}`, serverID, tag, hide)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/net/context"
@@ -29,6 +30,7 @@ func resourceDiscordMemberRoles() *schema.Resource {
 		ReadContext:   resourceMemberRolesRead,
 		UpdateContext: resourceMemberRolesUpdate,
 		DeleteContext: resourceMemberRolesDelete,
+		CustomizeDiff: resourceServerIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -41,12 +43,7 @@ func resourceDiscordMemberRoles() *schema.Resource {
 				Required:    true,
 				Description: "ID of the user to manage roles for.",
 			},
-			"server_id": {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Description: "ID of the server to manage roles in.",
-			},
+			"server_id": serverIdSchema("ID of the server to manage roles in.", true),
 			"role": {
 				Type:        schema.TypeSet,
 				Required:    true,
@@ -76,7 +73,10 @@ func resourceMemberRolesCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	client := m.(*Context).Session
 
-	serverId := d.Get("server_id").(string)
+	serverId, err := getServerId(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	userId := d.Get("user_id").(string)
 
 	if _, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx)); err != nil {
@@ -84,6 +84,7 @@ func resourceMemberRolesCreate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	d.SetId(generateTwoPartId(serverId, userId))
+	d.Set("server_id", serverId)
 
 	diags = append(diags, resourceMemberRolesRead(ctx, d, m)...)
 	diags = append(diags, resourceMemberRolesUpdate(ctx, d, m)...)
@@ -105,10 +106,18 @@ func resourceMemberRolesRead(ctx context.Context, d *schema.ResourceData, m inte
 	} else {
 		serverId = sId
 		userId = uId
+
+		d.Set("server_id", serverId)
+		d.Set("user_id", userId)
 	}
 
 	member, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
 	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Member not found. Removing from state", map[string]interface{}{"user_id": userId, "server_id": serverId})
+			d.SetId("")
+			return diags
+		}
 		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
 	}
 
@@ -199,6 +208,10 @@ func resourceMemberRolesDelete(ctx context.Context, d *schema.ResourceData, m in
 
 	member, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
 	if err != nil {
+		// A member who left the server has no roles left to remove.
+		if isDiscordNotFound(err) {
+			return diags
+		}
 		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
 	}
 
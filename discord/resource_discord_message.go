@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/net/context"
@@ -70,214 +71,7 @@ func resourceDiscordMessage() *schema.Resource {
 				Optional:     true,
 				MaxItems:     1,
 				Description:  "An embed block. At least one of `content` or `embed` must be set.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"title": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Title of the embed.",
-						},
-						"description": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Description of the embed.",
-						},
-						"url": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "URL of the embed.",
-						},
-						"timestamp": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Timestamp of the embed content.",
-						},
-						"color": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Color of the embed. Must be an integer color code.",
-						},
-						"footer": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Footer of the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"text": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Text of the footer.",
-									},
-									"icon_url": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "URL to an icon to be included in the footer.",
-									},
-								},
-							},
-						},
-						"image": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Image to be included in the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"url": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "URL of the image to be included in the embed.",
-									},
-									"proxy_url": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "URL to access the image via Discord's proxy.",
-									},
-									"height": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Height of the image.",
-									},
-									"width": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Width of the image.",
-									},
-								},
-							},
-						},
-						"thumbnail": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Thumbnail to be included in the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"url": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "URL of the thumbnail to be included in the embed.",
-									},
-									"proxy_url": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "URL to access the thumbnail via Discord's proxy.",
-									},
-									"height": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Height of the thumbnail.",
-									},
-									"width": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Width of the thumbnail.",
-									},
-								},
-							},
-						},
-						"video": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Video to be included in the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"url": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "URL of the video to be included in the embed.",
-									},
-									"height": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Height of the video.",
-									},
-									"width": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Width of the video.",
-									},
-								},
-							},
-						},
-						"provider": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Provider of the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Name of the provider.",
-									},
-									"url": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "URL of the provider.",
-									},
-								},
-							},
-						},
-						"author": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Author of the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Name of the author.",
-									},
-									"url": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "URL of the author.",
-									},
-									"icon_url": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "URL of the author's icon.",
-									},
-									"proxy_icon_url": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "URL to access the author's icon via Discord's proxy.",
-									},
-								},
-							},
-						},
-						"fields": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "Fields of the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Name of the field.",
-									},
-									"value": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Value of the field.",
-									},
-									"inline": {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Description: "Whether the field is inline.",
-									},
-								},
-							},
-						},
-					},
-				},
+				Elem:         embedSchema(),
 			},
 			"pinned": {
 				Type:        schema.TypeBool,
@@ -353,6 +147,11 @@ func resourceMessageRead(ctx context.Context, d *schema.ResourceData, m interfac
 	messageId := d.Id()
 	message, err := client.ChannelMessage(channelId, messageId, discordgo.WithContext(ctx))
 	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Message not found. Removing from state", map[string]interface{}{"message_id": messageId, "channel_id": channelId})
+			d.SetId("")
+			return diags
+		}
 		return diag.Errorf("Failed to fetch message %s in %s: %s", messageId, channelId, err.Error())
 	}
 
@@ -437,7 +236,7 @@ func resourceMessageDelete(ctx context.Context, d *schema.ResourceData, m interf
 
 	channelId := d.Get("channel_id").(string)
 	messageId := d.Id()
-	if err := client.ChannelMessageDelete(channelId, messageId, discordgo.WithContext(ctx)); err != nil {
+	if err := client.ChannelMessageDelete(channelId, messageId, discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to delete message %s in %s: %s", messageId, channelId, err.Error())
 	} else {
 		return diags
@@ -15,8 +15,8 @@ func TestAccResourceDiscordMessageContent(t *testing.T) {
 	}
 	name := "discord_message.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordMessageContent(testChannelID),
@@ -37,8 +37,8 @@ func TestAccResourceDiscordMessageEmbed(t *testing.T) {
 	}
 	name := "discord_message.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordEmbed(testChannelID),
@@ -1,6 +1,7 @@
 package discord
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,22 +11,11 @@ func resourceDiscordNewsChannel() *schema.Resource {
 		ReadContext:   resourceChannelRead,
 		UpdateContext: resourceChannelUpdate,
 		DeleteContext: resourceChannelDelete,
+		CustomizeDiff: customdiff.All(resourceServerIdCustomizeDiff, resourceDeletionProtectionCustomizeDiff, resourcePermissionOverwriteCustomizeDiff),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Description: "A resource to create a news channel.",
-		Schema: getChannelSchema("news", map[string]*schema.Schema{
-			"topic": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Topic of the channel.",
-			},
-			"nsfw": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Whether the channel is NSFW.",
-			},
-		}),
+		Description: "A resource to create a news channel. It can be converted into a text channel without recreating it by setting `type` to `text`.",
+		Schema:      getChannelSchema("news", textChannelSchema()),
 	}
 }
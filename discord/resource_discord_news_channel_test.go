@@ -15,8 +15,8 @@ func TestAccResourceDiscordNewsChannel(t *testing.T) {
 	}
 	name := "discord_news_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordNewsChannel(testServerID),
@@ -46,3 +46,42 @@ func testAccResourceDiscordNewsChannel(serverID string) string {
       sync_perms_with_category = false
 	}`, serverID)
 }
+
+func TestAccResourceDiscordNewsChannelSettings(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_news_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordNewsChannelSettings(testServerID, 1440, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "default_auto_archive_duration", "1440"),
+					resource.TestCheckResourceAttr(name, "default_thread_rate_limit_per_user", "5"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordNewsChannelSettings(testServerID, 60, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "default_auto_archive_duration", "60"),
+					resource.TestCheckResourceAttr(name, "default_thread_rate_limit_per_user", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordNewsChannelSettings(serverID string, archiveDuration int, threadRateLimit int) string {
+	return fmt.Sprintf(`
+	resource "discord_news_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-news-settings"
+	  sync_perms_with_category = false
+	  default_auto_archive_duration = %[2]d
+	  default_thread_rate_limit_per_user = %[3]d
+	}`, serverID, archiveDuration, threadRateLimit)
+}
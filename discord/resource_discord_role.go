@@ -2,9 +2,12 @@ package discord
 
 import (
 	"context"
+	"time"
+
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -15,18 +18,17 @@ func resourceDiscordRole() *schema.Resource {
 		ReadContext:   resourceRoleRead,
 		UpdateContext: resourceRoleUpdate,
 		DeleteContext: resourceRoleDelete,
+		CustomizeDiff: customdiff.All(resourceServerIdCustomizeDiff, resourceDeletionProtectionCustomizeDiff),
+		// Moving a role reorders every role below it.
+		Timeouts: resourceTimeouts(10 * time.Minute),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceRoleImport,
 		},
 
 		Description: "A resource to create a role.",
 		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Which server the role will be in.",
-			},
+			"server_id":           serverIdSchema("Which server the role will be in.", true),
+			"deletion_protection": deletionProtectionSchema("role"),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -97,8 +99,11 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface
 	var diags diag.Diagnostics
 	client := m.(*Context).Session
 
-	serverId := d.Get("server_id").(string)
-	server, err := client.Guild(serverId)
+	serverId, err := getServerId(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	server, err := m.(*Context).getGuild(ctx, serverId)
 	if err != nil {
 		return diag.Errorf("Server does not exist with that ID: %s", serverId)
 	}
@@ -146,16 +151,15 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
 
-	role, err := getRole(ctx, client, d.Get("server_id").(string), d.Id())
+	role, err := getRole(ctx, m, d.Get("server_id").(string), d.Id())
 
-	if err != nil {
+	if err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to fetch role %s: %s", d.Id(), err.Error())
 	}
 	if role == nil {
-		d.SetId("")
 		tflog.Warn(ctx, "Role not found. Removing from state", map[string]interface{}{"role_id": d.Id(), "server_id": d.Get("server_id")})
+		d.SetId("")
 		return diags
 	}
 
@@ -176,19 +180,19 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	client := m.(*Context).Session
 
 	serverId := d.Get("server_id").(string)
-	server, err := client.Guild(serverId)
+	server, err := m.(*Context).getGuild(ctx, serverId)
 	if err != nil {
 		return diag.Errorf("Failed to fetch server %s: %s", serverId, err.Error())
 	}
 
 	roleId := d.Id()
-	role, err := getRole(ctx, client, serverId, roleId)
+	role, err := getRole(ctx, m, serverId, roleId)
 	if err != nil {
 		return diag.Errorf("Failed to fetch role %s: %s", d.Id(), err.Error())
 	}
 	if role == nil {
-		d.SetId("")
 		tflog.Warn(ctx, "Role not found. Removing from state", map[string]interface{}{"role_id": d.Id(), "server_id": d.Get("server_id")})
+		d.SetId("")
 		return diags
 	}
 
@@ -252,8 +256,12 @@ func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface
 	var diags diag.Diagnostics
 	client := m.(*Context).Session
 
+	if diags := checkDeletionProtection(d, "role"); diags.HasError() {
+		return diags
+	}
+
 	serverId := d.Get("server_id")
-	if err := client.GuildRoleDelete(serverId.(string), d.Id(), discordgo.WithContext(ctx)); err != nil {
+	if err := client.GuildRoleDelete(serverId.(string), d.Id(), discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to delete role: %s", err.Error())
 	}
 
@@ -2,6 +2,7 @@ package discord
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/net/context"
@@ -12,6 +13,7 @@ func resourceDiscordRoleEveryone() *schema.Resource {
 		CreateContext: resourceRoleEveryoneRead,
 		ReadContext:   resourceRoleEveryoneRead,
 		UpdateContext: resourceRoleEveryoneUpdate,
+		CustomizeDiff: resourceServerIdCustomizeDiff,
 		DeleteContext: func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
 			return []diag.Diagnostic{{
 				Severity: diag.Warning,
@@ -24,12 +26,7 @@ func resourceDiscordRoleEveryone() *schema.Resource {
 
 		Description: "Resource to manage permissions for the default `@everyone` role.",
 		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Which server the role will be in.",
-			},
+			"server_id": serverIdSchema("Which server the role will be in.", true),
 			"permissions": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -55,26 +52,39 @@ func resourceRoleEveryoneImport(ctx context.Context, data *schema.ResourceData,
 
 func resourceRoleEveryoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
 
-	serverId := d.Get("server_id").(string)
+	serverId, err := getServerId(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(serverId)
+	d.Set("server_id", serverId)
 
-	if role, err := getRole(ctx, client, serverId, serverId); err != nil {
+	role, err := getRole(ctx, m, serverId, serverId)
+	if err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to fetch role %s: %s", d.Id(), err.Error())
-	} else {
-		d.Set("permissions", role.Permissions)
-
+	}
+	if role == nil {
+		tflog.Warn(ctx, "Server not found. Removing from state", map[string]interface{}{"server_id": serverId})
+		d.SetId("")
 		return diags
 	}
+
+	d.Set("permissions", role.Permissions)
+
+	return diags
 }
 
 func resourceRoleEveryoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*Context).Session
 
-	serverId := d.Get("server_id").(string)
+	serverId, err := getServerId(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(serverId)
+	d.Set("server_id", serverId)
 	newPermission := int64(d.Get("permissions").(int))
 
 	if role, err := client.GuildRoleEdit(serverId, serverId, &discordgo.RoleParams{
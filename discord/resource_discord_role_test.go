@@ -15,8 +15,8 @@ func TestAccResourceDiscordRole(t *testing.T) {
 	}
 	name := "discord_role.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordRole(testServerID),
@@ -50,3 +50,35 @@ func testAccResourceDiscordRole(channelID string) string {
         permissions = 1024
 	}`, channelID)
 }
+
+func TestAccResourceDiscordRoleProviderServerId(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_role.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordRoleProviderServerId(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-test-default-server-role"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordRoleProviderServerId(serverID string) string {
+	return fmt.Sprintf(`
+	provider "discord" {
+		server_id = "%[1]s"
+	}
+
+	resource "discord_role" "example" {
+		name = "terraform-test-default-server-role"
+	}`, serverID)
+}
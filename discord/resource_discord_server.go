@@ -3,11 +3,12 @@ package discord
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/polds/imgbase64"
 	"golang.org/x/net/context"
 )
 
@@ -196,6 +197,7 @@ func serverSchema() map[string]*schema.Schema {
 		Required:    true,
 		Description: "Name of the server.",
 	}
+	res["deletion_protection"] = deletionProtectionSchema("server")
 
 	return res
 }
@@ -206,6 +208,9 @@ func resourceDiscordServer() *schema.Resource {
 		ReadContext:   resourceServerRead,
 		UpdateContext: resourceServerUpdate,
 		DeleteContext: resourceServerDelete,
+		CustomizeDiff: resourceDeletionProtectionCustomizeDiff,
+		// Creating a server with its channels and roles can hit several rate limits.
+		Timeouts: resourceTimeouts(10 * time.Minute),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -236,7 +241,11 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	icon := ""
 	if v, ok := d.GetOk("icon_url"); ok {
-		icon = imgbase64.FromRemote(v.(string))
+		img, err := fetchImage(ctx, m, v.(string))
+		if err != nil {
+			return diag.Errorf("Failed to fetch icon: %s", err.Error())
+		}
+		icon = img
 	}
 	if v, ok := d.GetOk("icon_data_uri"); ok {
 		icon = v.(string)
@@ -251,7 +260,11 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	splash := ""
 	if v, ok := d.GetOk("splash_url"); ok {
-		splash = imgbase64.FromRemote(v.(string))
+		img, err := fetchImage(ctx, m, v.(string))
+		if err != nil {
+			return diag.Errorf("Failed to fetch splash: %s", err.Error())
+		}
+		splash = img
 	}
 	if v, ok := d.GetOk("splash_data_uri"); ok {
 		splash = v.(string)
@@ -285,7 +298,7 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	for _, channel := range server.Channels {
-		if _, err := client.ChannelDelete(channel.ID); err != nil {
+		if _, err := client.ChannelDelete(channel.ID, discordgo.WithContext(ctx)); err != nil {
 			return diag.Errorf("Failed to delete channel for new server: %s", err.Error())
 		}
 	}
@@ -348,10 +361,14 @@ func resourceServerManagedCreate(ctx context.Context, d *schema.ResourceData, m
 
 func resourceServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
 
-	server, err := client.Guild(d.Id(), discordgo.WithContext(ctx))
+	server, err := m.(*Context).getGuild(ctx, d.Id())
 	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Server not found. Removing from state", map[string]interface{}{"server_id": d.Id()})
+			d.SetId("")
+			return diags
+		}
 		return diag.Errorf("Error fetching server: %s", err.Error())
 	}
 
@@ -402,7 +419,11 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	edit := false
 
 	if d.HasChange("icon_url") {
-		guildParams.Icon = imgbase64.FromRemote(d.Get("icon_url").(string))
+		img, err := fetchImage(ctx, m, d.Get("icon_url").(string))
+		if err != nil {
+			return diag.Errorf("Failed to fetch icon: %s", err.Error())
+		}
+		guildParams.Icon = img
 		edit = true
 	}
 	if d.HasChange("icon_data_uri") {
@@ -410,7 +431,11 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		edit = true
 	}
 	if d.HasChange("splash_url") {
-		guildParams.Splash = imgbase64.FromRemote(d.Get("splash_url").(string))
+		img, err := fetchImage(ctx, m, d.Get("splash_url").(string))
+		if err != nil {
+			return diag.Errorf("Failed to fetch splash: %s", err.Error())
+		}
+		guildParams.Splash = img
 		edit = true
 	}
 	if d.HasChange("splash_data_uri") {
@@ -479,7 +504,11 @@ func resourceServerDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	var diags diag.Diagnostics
 	client := m.(*Context).Session
 
-	if err := client.GuildDelete(d.Id(), discordgo.WithContext(ctx)); err != nil {
+	if diags := checkDeletionProtection(d, "server"); diags.HasError() {
+		return diags
+	}
+
+	if err := client.GuildDelete(d.Id(), discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to delete server: %s", err)
 	}
 
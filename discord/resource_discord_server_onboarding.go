@@ -16,6 +16,7 @@ func resourceDiscordServerOnboarding() *schema.Resource {
 		ReadContext:   resourceServerOnboardingRead,
 		UpdateContext: resourceServerOnboardingUpdate,
 		DeleteContext: resourceServerOnboardingDelete,
+		CustomizeDiff: resourceServerIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -23,12 +24,7 @@ func resourceDiscordServerOnboarding() *schema.Resource {
 		Description: "Manages server onboarding configuration. Onboarding allows new members to customize their experience by answering questions that automatically assign roles and grant channel access. Requires at least 1 default channel.",
 
 		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The ID of the server to configure onboarding for.",
-			},
+			"server_id": serverIdSchema("The ID of the server to configure onboarding for.", true),
 			"enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -159,10 +155,13 @@ func resourceDiscordServerOnboarding() *schema.Resource {
 func resourceServerOnboardingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Context).Session
 
-	serverID := d.Get("server_id").(string)
+	serverID, err := getServerId(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Verify server exists
-	if _, err := client.Guild(serverID, discordgo.WithContext(ctx)); err != nil {
+	if _, err := m.(*Context).getGuild(ctx, serverID); err != nil {
 		return diag.Errorf("Server does not exist with ID %s: %s", serverID, err.Error())
 	}
 
@@ -190,6 +189,9 @@ func resourceServerOnboardingRead(ctx context.Context, d *schema.ResourceData, m
 
 	onboarding, err := client.GuildOnboarding(serverID, discordgo.WithContext(ctx))
 	if err != nil {
+		if !isDiscordNotFound(err) {
+			return diag.Errorf("Failed to fetch onboarding for server %s: %s", serverID, err.Error())
+		}
 		// If server or onboarding doesn't exist, remove from state
 		tflog.Warn(ctx, "Failed to fetch onboarding, removing from state", map[string]interface{}{
 			"server_id": serverID,
@@ -235,7 +237,7 @@ func resourceServerOnboardingDelete(ctx context.Context, d *schema.ResourceData,
 		Prompts:           &[]discordgo.GuildOnboardingPrompt{},
 	}
 
-	if _, err := client.GuildOnboardingEdit(serverID, onboarding, discordgo.WithContext(ctx)); err != nil {
+	if _, err := client.GuildOnboardingEdit(serverID, onboarding, discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to disable onboarding for server %s: %s", serverID, err.Error())
 	}
 
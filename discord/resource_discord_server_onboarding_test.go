@@ -16,8 +16,8 @@ func TestAccResourceDiscordServerOnboarding(t *testing.T) {
 
 	name := "discord_server_onboarding.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordServerOnboardingBasic(testServerID),
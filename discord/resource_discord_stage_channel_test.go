@@ -0,0 +1,65 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordStageChannel(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_stage_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordStageChannel(testServerID, `rtc_region = "rotterdam"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-stage-channel"),
+					resource.TestCheckResourceAttr(name, "type", "stage"),
+					resource.TestCheckResourceAttr(name, "bitrate", "64000"),
+					resource.TestCheckResourceAttr(name, "user_limit", "50"),
+					resource.TestCheckResourceAttr(name, "rtc_region", "rotterdam"),
+					resource.TestCheckResourceAttrPair(name, "category", "discord_category_channel.example", "id"),
+					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "true"),
+					resource.TestCheckResourceAttrSet(name, "channel_id"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordStageChannel(testServerID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rtc_region", ""),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordStageChannel(serverID string, region string) string {
+	return fmt.Sprintf(`
+	resource "discord_category_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-stage-category"
+	}
+
+	resource "discord_stage_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-stage-channel"
+	  category = discord_category_channel.example.id
+	  user_limit = 50
+	  %[2]s
+	}`, serverID, region)
+}
@@ -2,6 +2,7 @@ package discord
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/net/context"
@@ -13,18 +14,14 @@ func resourceDiscordSystemChannel() *schema.Resource {
 		ReadContext:   resourceSystemChannelRead,
 		UpdateContext: resourceSystemChannelUpdate,
 		DeleteContext: resourceSystemChannelDelete,
+		CustomizeDiff: resourceServerIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Description: "Manage the system channel of a Discord server.",
 		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The ID of the server to manage the system channel for.",
-			},
+			"server_id": serverIdSchema("The ID of the server to manage the system channel for.", true),
 			"system_channel_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -43,9 +40,12 @@ func resourceSystemChannelCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	client := m.(*Context).Session
 
-	serverId := d.Get("server_id").(string)
+	serverId, err := getServerId(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	server, err := client.Guild(serverId, discordgo.WithContext(ctx))
+	server, err := m.(*Context).getGuild(ctx, serverId)
 	if err != nil {
 		return diag.Errorf("Failed to find server: %s", err.Error())
 	}
@@ -64,21 +64,27 @@ func resourceSystemChannelCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.SetId(serverId)
+	d.Set("server_id", serverId)
 
 	return diags
 }
 
 func resourceSystemChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
 
 	serverId := d.Id()
 
-	server, err := client.Guild(serverId, discordgo.WithContext(ctx))
+	server, err := m.(*Context).getGuild(ctx, serverId)
 	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Server not found. Removing from state", map[string]interface{}{"server_id": serverId})
+			d.SetId("")
+			return diags
+		}
 		return diag.Errorf("Error fetching server: %s", err.Error())
 	}
 
+	d.Set("server_id", server.ID)
 	d.Set("system_channel_id", server.SystemChannelID)
 
 	return diags
@@ -89,7 +95,7 @@ func resourceSystemChannelUpdate(ctx context.Context, d *schema.ResourceData, m
 	client := m.(*Context).Session
 
 	serverId := d.Get("server_id").(string)
-	_, err := client.Guild(serverId, discordgo.WithContext(ctx))
+	_, err := m.(*Context).getGuild(ctx, serverId)
 
 	if err != nil {
 		return diag.Errorf("Error fetching server: %s", err.Error())
@@ -115,7 +121,7 @@ func resourceSystemChannelDelete(ctx context.Context, d *schema.ResourceData, m
 
 	if _, err := client.GuildEdit(serverID, &discordgo.GuildParams{
 		SystemChannelID: "",
-	}, discordgo.WithContext(ctx)); err != nil {
+	}, discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
 		return diag.Errorf("Failed to edit server: %s: %s", serverID, err.Error())
 	}
 
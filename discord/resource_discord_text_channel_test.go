@@ -1,11 +1,15 @@
 package discord
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceDiscordTextChannel(t *testing.T) {
@@ -15,8 +19,8 @@ func TestAccResourceDiscordTextChannel(t *testing.T) {
 	}
 	name := "discord_text_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordTextChannel(testServerID),
@@ -35,6 +39,140 @@ func TestAccResourceDiscordTextChannel(t *testing.T) {
 	})
 }
 
+func TestAccResourceDiscordTextChannelSettings(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_text_channel.example"
+	var channelID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordTextChannelSettings(testServerID, 30, 4320),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "30"),
+					resource.TestCheckResourceAttr(name, "default_auto_archive_duration", "4320"),
+					resource.TestCheckResourceAttr(name, "default_thread_rate_limit_per_user", "10"),
+					func(s *terraform.State) error {
+						channelID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceDiscordTextChannelSettings(testServerID, 0, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "0"),
+					resource.TestCheckResourceAttr(name, "default_auto_archive_duration", "60"),
+				),
+			},
+			{
+				// Slowmode changed outside of Terraform shows up as drift.
+				PreConfig: func() {
+					rateLimit := 120
+					if _, err := testAccClient(t).Session.ChannelEditComplex(channelID, &discordgo.ChannelEdit{RateLimitPerUser: &rateLimit}); err != nil {
+						t.Fatalf("err: %s", err)
+					}
+				},
+				Config:             testAccResourceDiscordTextChannelSettings(testServerID, 0, 60),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceDiscordTextChannelSettings(testServerID, 0, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordTextChannelPermissionOverwrites(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testRoleID := os.Getenv("DISCORD_TEST_ROLE_ID")
+	testUserID := os.Getenv("DISCORD_TEST_USER_ID")
+	if testServerID == "" || testRoleID == "" || testUserID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID, DISCORD_TEST_ROLE_ID, and DISCORD_TEST_USER_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_text_channel.example"
+	var channelID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordTextChannelPermissionOverwrites(testServerID, testRoleID, 1024) + fmt.Sprintf(`
+	resource "discord_category_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-overwrites-category"
+	}
+
+	resource "discord_text_channel" "synced" {
+	  server_id = "%[1]s"
+	  name = "terraform-overwrites-synced"
+	  category = discord_category_channel.example.id
+
+	  permission_overwrite {
+	    type = "role"
+	    overwrite_id = "%[1]s"
+	    deny = 1024
+	  }
+	}`, testServerID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("set sync_perms_with_category to false"),
+			},
+			{
+				Config: testAccResourceDiscordTextChannelPermissionOverwrites(testServerID, testRoleID, 1024),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "permission_overwrite.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "permission_overwrite.*", map[string]string{
+						"type":         "role",
+						"overwrite_id": testServerID,
+						"allow":        "0",
+						"deny":         "1024",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "permission_overwrite.*", map[string]string{
+						"type":         "role",
+						"overwrite_id": testRoleID,
+						"allow":        "1024",
+						"deny":         "0",
+					}),
+					func(s *terraform.State) error {
+						channelID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Overwrites added outside of Terraform show up as drift.
+				PreConfig: func() {
+					if err := testAccClient(t).Session.ChannelPermissionSet(channelID, testUserID, discordgo.PermissionOverwriteTypeMember, 2048, 0); err != nil {
+						t.Fatalf("err: %s", err)
+					}
+				},
+				Config:             testAccResourceDiscordTextChannelPermissionOverwrites(testServerID, testRoleID, 1024),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceDiscordTextChannelPermissionOverwrites(testServerID, testRoleID, 3072),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "permission_overwrite.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "permission_overwrite.*", map[string]string{
+						"type":         "role",
+						"overwrite_id": testRoleID,
+						"allow":        "3072",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceDiscordTextChannel(serverID string) string {
 	return fmt.Sprintf(`
 	resource "discord_text_channel" "example" {
@@ -46,3 +184,268 @@ func testAccResourceDiscordTextChannel(serverID string) string {
       sync_perms_with_category = false
 	}`, serverID)
 }
+
+func testAccResourceDiscordTextChannelSettings(serverID string, rateLimit int, archiveDuration int) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-text-settings"
+	  sync_perms_with_category = false
+	  rate_limit_per_user = %[2]d
+	  default_auto_archive_duration = %[3]d
+	  default_thread_rate_limit_per_user = 10
+	}`, serverID, rateLimit, archiveDuration)
+}
+
+func testAccResourceDiscordTextChannelPermissionOverwrites(serverID string, roleID string, allow int) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-text-overwrites"
+	  sync_perms_with_category = false
+
+	  permission_overwrite {
+	    type = "role"
+	    overwrite_id = "%[1]s"
+	    deny = 1024
+	  }
+	  permission_overwrite {
+	    type = "role"
+	    overwrite_id = "%[2]s"
+	    allow = %[3]d
+	  }
+	}`, serverID, roleID, allow)
+}
+
+func TestAccResourceDiscordTextChannelPlacement(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	var secondID, thirdID string
+	// moveChannels sets the positions of the second and third channels
+	// outside of Terraform.
+	moveChannels := func(second int, third int) func() {
+		return func() {
+			client := testAccClient(t).Session
+			if _, err := client.ChannelEdit(secondID, &discordgo.ChannelEdit{Position: &second}); err != nil {
+				t.Fatalf("Failed to move channel %s: %s", secondID, err.Error())
+			}
+			if _, err := client.ChannelEdit(thirdID, &discordgo.ChannelEdit{Position: &third}); err != nil {
+				t.Fatalf("Failed to move channel %s: %s", thirdID, err.Error())
+			}
+		}
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordTextChannelPlacement(testServerID, "after_channel_id = discord_text_channel.first.id", "after_channel_id = discord_text_channel.second.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_text_channel.first", "position_in_category", "0"),
+					resource.TestCheckResourceAttrPair("discord_text_channel.second", "after_channel_id", "discord_text_channel.first", "id"),
+					resource.TestCheckResourceAttr("discord_text_channel.second", "position_in_category", "1"),
+					resource.TestCheckResourceAttr("discord_text_channel.third", "position_in_category", "2"),
+					func(s *terraform.State) error {
+						secondID = s.RootModule().Resources["discord_text_channel.second"].Primary.ID
+						thirdID = s.RootModule().Resources["discord_text_channel.third"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Renumbering the channels without reordering them isn't drift.
+				PreConfig: moveChannels(4, 8),
+				Config:    testAccResourceDiscordTextChannelPlacement(testServerID, "after_channel_id = discord_text_channel.first.id", "after_channel_id = discord_text_channel.second.id"),
+				PlanOnly:  true,
+			},
+			{
+				// Reordering them is.
+				PreConfig:          moveChannels(4, 2),
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discord_text_channel.second", "after_channel_id", "discord_text_channel.third", "id"),
+					resource.TestCheckResourceAttr("discord_text_channel.third", "position_in_category", "1"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordTextChannelPlacement(testServerID, "after_channel_id = discord_text_channel.first.id", "after_channel_id = discord_text_channel.second.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discord_text_channel.third", "after_channel_id", "discord_text_channel.second", "id"),
+					resource.TestCheckResourceAttr("discord_text_channel.second", "position_in_category", "1"),
+					resource.TestCheckResourceAttr("discord_text_channel.third", "position_in_category", "2"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordTextChannelPlacement(testServerID, "position = 2", "before_channel_id = discord_text_channel.second.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discord_text_channel.third", "before_channel_id", "discord_text_channel.second", "id"),
+					resource.TestCheckResourceAttr("discord_text_channel.third", "position_in_category", "1"),
+				),
+			},
+			{
+				// Placing a channel moves the ones after it.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_text_channel.second", "position", "2"),
+					resource.TestCheckResourceAttr("discord_text_channel.second", "position_in_category", "2"),
+				),
+			},
+			{
+				Config:      testAccResourceDiscordTextChannelPlacement(testServerID, "after_channel_id = discord_text_channel.first.id", "after_channel_id = discord_text_channel.second.id\n  position = 3"),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordTextChannelPlacement(serverID string, secondPlacement string, thirdPlacement string) string {
+	return fmt.Sprintf(`
+	resource "discord_category_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-placement"
+	}
+
+	resource "discord_text_channel" "first" {
+	  server_id = "%[1]s"
+	  name = "terraform-placement-first"
+	  category = discord_category_channel.example.id
+	  position = 0
+	}
+
+	resource "discord_text_channel" "second" {
+	  server_id = "%[1]s"
+	  name = "terraform-placement-second"
+	  category = discord_category_channel.example.id
+	  %[2]s
+	}
+
+	resource "discord_text_channel" "third" {
+	  server_id = "%[1]s"
+	  name = "terraform-placement-third"
+	  category = discord_category_channel.example.id
+	  %[3]s
+	}`, serverID, secondPlacement, thirdPlacement)
+}
+
+func TestAccResourceDiscordTextChannelConversion(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_text_channel.example"
+	var channelID, messageID string
+	// checkKept checks the channel and its message weren't recreated.
+	checkKept := func(s *terraform.State) error {
+		channel := s.RootModule().Resources[name].Primary.ID
+		message := s.RootModule().Resources["discord_message.example"].Primary.ID
+		if channelID == "" {
+			channelID, messageID = channel, message
+		} else if channel != channelID || message != messageID {
+			return fmt.Errorf("channel or message recreated: ex: %s/%s, ac: %s/%s", channelID, messageID, channel, message)
+		}
+		return nil
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Other types can't be converted into.
+				Config:      testAccResourceDiscordTextChannelConversion(testServerID, `type = "voice"`),
+				ExpectError: regexp.MustCompile(`type must be text, voice passed`),
+			},
+			{
+				Config: testAccResourceDiscordTextChannelConversion(testServerID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "text"),
+					checkKept,
+				),
+			},
+			{
+				Config: testAccResourceDiscordTextChannelConversion(testServerID, `type = "news"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "news"),
+					checkKept,
+					func(s *terraform.State) error {
+						channel, err := testAccClient(t).Session.Channel(channelID)
+						if err != nil {
+							return err
+						}
+						if channel.Type != discordgo.ChannelTypeGuildNews {
+							return fmt.Errorf("channel %s has type %d, expected %d", channelID, channel.Type, discordgo.ChannelTypeGuildNews)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Converting the channel outside of Terraform shows up as drift.
+				PreConfig: func() {
+					textType := discordgo.ChannelTypeGuildText
+					if _, err := editChannel(context.Background(), testAccClient(t).Session, channelID, channelEditData{Type: &textType}); err != nil {
+						t.Fatalf("Failed to convert channel %s: %s", channelID, err.Error())
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check:              resource.TestCheckResourceAttr(name, "type", "text"),
+			},
+			{
+				Config: testAccResourceDiscordTextChannelConversion(testServerID, `type = "news"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "news"),
+					checkKept,
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordTextChannelConversion(serverID string, channelType string) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-text-conversion"
+	  sync_perms_with_category = false
+	  %[2]s
+	}
+
+	resource "discord_message" "example" {
+	  channel_id = discord_text_channel.example.id
+	  content = "Kept across conversions"
+	}`, serverID, channelType)
+}
+
+func TestAccResourceDiscordTextChannelWithoutCategory(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_text_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// sync_perms_with_category defaults to true, which a channel
+				// without a category has nothing to sync with.
+				Config: testAccResourceDiscordTextChannelWithoutCategory(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(name, "category"),
+					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordTextChannelWithoutCategory(serverID string) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-text-channel-without-category"
+	}`, serverID)
+}
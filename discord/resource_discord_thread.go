@@ -0,0 +1,275 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDiscordThread() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceThreadCreate,
+		ReadContext:   resourceThreadRead,
+		UpdateContext: resourceThreadUpdate,
+		DeleteContext: resourceThreadDelete,
+		CustomizeDiff: resourceThreadCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceThreadImport,
+		},
+
+		Description: "A resource to create a thread in a text or news channel. Can be imported with `channel_id:thread_id`.",
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the channel the thread is in.",
+			},
+			"message_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of an existing message of the channel to start the thread from. The thread then has the same ID as the message.",
+			},
+			"server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the server the thread is in.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "Name of the thread.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+				Description:  "Type of the thread, `public` or `private`. Threads started from a message are always public.",
+			},
+			"auto_archive_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{60, 1440, 4320, 10080}),
+				Description:  "Number of minutes of inactivity after which the thread is archived. One of `60`, `1440`, `4320` or `10080`. Defaults to the channel's `default_auto_archive_duration`.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether only members with the Manage Threads permission can unarchive the thread.",
+			},
+			"archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the thread is archived. Discord archives inactive threads on its own, which shows up as a change to apply.",
+			},
+			"invitable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether members who aren't moderators can add other members to the thread. Can only be `false` on private threads.",
+			},
+			"rate_limit_per_user": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 21600),
+				Description:  "Slowmode of the thread: the number of seconds a member has to wait between two messages.",
+			},
+		},
+	}
+}
+
+// getThreadType returns the type of a thread channel type, and whether it
+// is one.
+func getThreadType(channelType discordgo.ChannelType) (string, bool) {
+	switch channelType {
+	case discordgo.ChannelTypeGuildNewsThread, discordgo.ChannelTypeGuildPublicThread:
+		return "public", true
+	case discordgo.ChannelTypeGuildPrivateThread:
+		return "private", true
+	}
+
+	return "", false
+}
+
+func resourceThreadCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("type").(string) == "private" && d.Get("message_id").(string) != "" {
+		return fmt.Errorf("threads started from a message are always public")
+	}
+	if d.Get("type").(string) == "public" && !d.Get("invitable").(bool) {
+		return fmt.Errorf("invitable only applies to private threads")
+	}
+
+	return nil
+}
+
+func resourceThreadImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if channelId, threadId, err := parseTwoIds(d.Id()); err != nil {
+		return nil, err
+	} else {
+		d.SetId(threadId)
+		d.Set("channel_id", channelId)
+
+		return schema.ImportStatePassthroughContext(ctx, d, m)
+	}
+}
+
+func resourceThreadCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Context).Session
+
+	channelId := d.Get("channel_id").(string)
+	data := &discordgo.ThreadStart{
+		Name:                d.Get("name").(string),
+		AutoArchiveDuration: d.Get("auto_archive_duration").(int),
+		RateLimitPerUser:    d.Get("rate_limit_per_user").(int),
+	}
+
+	var (
+		thread *discordgo.Channel
+		err    error
+	)
+	if messageId := d.Get("message_id").(string); messageId != "" {
+		thread, err = client.MessageThreadStartComplex(channelId, messageId, data, discordgo.WithContext(ctx))
+	} else {
+		parent, parentErr := m.(*Context).getChannel(ctx, "", channelId)
+		if parentErr != nil {
+			return diag.Errorf("Failed to fetch channel %s: %s", channelId, parentErr.Error())
+		}
+
+		switch {
+		case d.Get("type").(string) == "private":
+			data.Type = discordgo.ChannelTypeGuildPrivateThread
+			data.Invitable = d.Get("invitable").(bool)
+		case parent.Type == discordgo.ChannelTypeGuildNews:
+			data.Type = discordgo.ChannelTypeGuildNewsThread
+		default:
+			data.Type = discordgo.ChannelTypeGuildPublicThread
+		}
+		thread, err = client.ThreadStartComplex(channelId, data, discordgo.WithContext(ctx))
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create thread in %s: %s", channelId, err.Error())
+	}
+
+	d.SetId(thread.ID)
+
+	// A thread is always created active and unlocked.
+	locked, archived := d.Get("locked").(bool), d.Get("archived").(bool)
+	if locked || archived {
+		if _, err := client.ChannelEdit(thread.ID, &discordgo.ChannelEdit{Locked: &locked, Archived: &archived}, discordgo.WithContext(ctx)); err != nil {
+			return diag.Errorf("Failed to update thread %s: %s", thread.ID, err.Error())
+		}
+	}
+
+	return resourceThreadRead(ctx, d, m)
+}
+
+func resourceThreadRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	thread, err := m.(*Context).getChannel(ctx, d.Get("server_id").(string), d.Id())
+	if err != nil {
+		if isDiscordNotFound(err) {
+			tflog.Warn(ctx, "Thread not found. Removing from state", map[string]interface{}{"thread_id": d.Id()})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("Failed to fetch thread %s: %s", d.Id(), err.Error())
+	}
+
+	threadType, ok := getThreadType(thread.Type)
+	if !ok || thread.ThreadMetadata == nil {
+		return diag.Errorf("Channel %s is not a thread", d.Id())
+	}
+
+	d.Set("channel_id", thread.ParentID)
+	d.Set("server_id", thread.GuildID)
+	d.Set("name", thread.Name)
+	d.Set("type", threadType)
+	d.Set("auto_archive_duration", thread.ThreadMetadata.AutoArchiveDuration)
+	d.Set("locked", thread.ThreadMetadata.Locked)
+	d.Set("archived", thread.ThreadMetadata.Archived)
+	d.Set("rate_limit_per_user", thread.RateLimitPerUser)
+	// Anyone can add members to a public thread.
+	d.Set("invitable", threadType == "public" || thread.ThreadMetadata.Invitable)
+
+	return diags
+}
+
+func resourceThreadUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Context).Session
+
+	thread, err := m.(*Context).getChannel(ctx, d.Get("server_id").(string), d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to fetch thread %s: %s", d.Id(), err.Error())
+	}
+
+	edit := &discordgo.ChannelEdit{}
+	if d.HasChange("name") {
+		edit.Name = d.Get("name").(string)
+	}
+	if d.HasChange("auto_archive_duration") {
+		edit.AutoArchiveDuration = d.Get("auto_archive_duration").(int)
+	}
+	if d.HasChange("locked") {
+		locked := d.Get("locked").(bool)
+		edit.Locked = &locked
+	}
+	if d.HasChange("invitable") && d.Get("type").(string) == "private" {
+		invitable := d.Get("invitable").(bool)
+		edit.Invitable = &invitable
+	}
+	if d.HasChange("rate_limit_per_user") {
+		rateLimit := d.Get("rate_limit_per_user").(int)
+		edit.RateLimitPerUser = &rateLimit
+	}
+
+	// An archived thread can only be edited by unarchiving it, so one that
+	// stays archived is archived again afterwards.
+	archived := d.Get("archived").(bool)
+	rearchive := false
+	if thread.ThreadMetadata != nil && thread.ThreadMetadata.Archived && archived {
+		if !d.HasChanges("name", "auto_archive_duration", "locked", "invitable", "rate_limit_per_user") {
+			return resourceThreadRead(ctx, d, m)
+		}
+		unarchived := false
+		edit.Archived = &unarchived
+		rearchive = true
+	} else {
+		edit.Archived = &archived
+	}
+
+	if _, err := client.ChannelEdit(d.Id(), edit, discordgo.WithContext(ctx)); err != nil {
+		return diag.Errorf("Failed to update thread %s: %s", d.Id(), err.Error())
+	}
+	if rearchive {
+		if _, err := client.ChannelEdit(d.Id(), &discordgo.ChannelEdit{Archived: &archived}, discordgo.WithContext(ctx)); err != nil {
+			return diag.Errorf("Failed to archive thread %s: %s", d.Id(), err.Error())
+		}
+	}
+
+	return resourceThreadRead(ctx, d, m)
+}
+
+func resourceThreadDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*Context).Session
+
+	if _, err := client.ChannelDelete(d.Id(), discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
+		return diag.Errorf("Failed to delete thread %s: %s", d.Id(), err.Error())
+	}
+
+	return diags
+}
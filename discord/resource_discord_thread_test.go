@@ -0,0 +1,147 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDiscordThread(t *testing.T) {
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testChannelID == "" {
+		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_thread.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-thread", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "channel_id", testChannelID),
+					resource.TestCheckResourceAttrSet(name, "server_id"),
+					resource.TestCheckResourceAttr(name, "name", "terraform-thread"),
+					resource.TestCheckResourceAttr(name, "type", "public"),
+					resource.TestCheckResourceAttr(name, "auto_archive_duration", "60"),
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "10"),
+					resource.TestCheckResourceAttr(name, "locked", "false"),
+					resource.TestCheckResourceAttr(name, "archived", "false"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-thread", `
+      locked = true
+      archived = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "locked", "true"),
+					resource.TestCheckResourceAttr(name, "archived", "true"),
+				),
+			},
+			{
+				// Renaming an archived thread unarchives it for the edit.
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-thread-renamed", `
+      locked = true
+      archived = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-thread-renamed"),
+					resource.TestCheckResourceAttr(name, "archived", "true"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-thread-renamed", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "locked", "false"),
+					resource.TestCheckResourceAttr(name, "archived", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccThreadImportStateId(name),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordThreadPrivate(t *testing.T) {
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testChannelID == "" {
+		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_thread.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-private-thread", `
+      type = "private"
+      invitable = false`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "private"),
+					resource.TestCheckResourceAttr(name, "invitable", "false"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-private-thread", `
+      type = "private"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "invitable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordThreadFromMessage(t *testing.T) {
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testChannelID == "" {
+		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_thread.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	resource "discord_message" "example" {
+      channel_id = "%[1]s"
+      content = "Discussion thread from Terraform"
+	}`, testChannelID) + testAccResourceDiscordThread(testChannelID, "terraform-message-thread", `
+      message_id = discord_message.example.id`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "discord_message.example", "id"),
+					resource.TestCheckResourceAttr(name, "type", "public"),
+				),
+			},
+		},
+	})
+}
+
+func testAccThreadImportStateId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+
+		return generateTwoPartId(rs.Primary.Attributes["channel_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccResourceDiscordThread(channelID string, name string, extra string) string {
+	return fmt.Sprintf(`
+	resource "discord_thread" "example" {
+      channel_id = "%[1]s"
+      name = "%[2]s"
+      auto_archive_duration = 60
+      rate_limit_per_user = 10
+      %[3]s
+	}`, channelID, name, extra)
+}
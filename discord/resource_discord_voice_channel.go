@@ -1,7 +1,9 @@
 package discord
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceDiscordVoiceChannel() *schema.Resource {
@@ -10,6 +12,7 @@ func resourceDiscordVoiceChannel() *schema.Resource {
 		ReadContext:   resourceChannelRead,
 		UpdateContext: resourceChannelUpdate,
 		DeleteContext: resourceChannelDelete,
+		CustomizeDiff: customdiff.All(resourceServerIdCustomizeDiff, resourceDeletionProtectionCustomizeDiff, resourcePermissionOverwriteCustomizeDiff),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,6 +29,31 @@ func resourceDiscordVoiceChannel() *schema.Resource {
 				Optional:    true,
 				Description: "User limit of the channel.",
 			},
+			"rtc_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Voice region of the channel, e.g. `rotterdam`. Discord picks the region automatically when unset.",
+			},
+			"video_quality_mode": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      videoQualityModeAuto,
+				ValidateFunc: validation.IntBetween(videoQualityModeAuto, videoQualityModeFull),
+				Description:  "Camera video quality of the channel. 1 = Auto, 2 = Full (720p).",
+			},
+			"nsfw": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the channel, including its text chat, is NSFW.",
+			},
+			"rate_limit_per_user": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 21600),
+				Description:  "Slowmode of the channel's text chat: the number of seconds a member has to wait between two messages.",
+			},
 		}),
 	}
 }
@@ -15,11 +15,11 @@ func TestAccResourceDiscordVoiceChannel(t *testing.T) {
 	}
 	name := "discord_voice_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceDiscordVoiceChannel(testServerID),
+				Config: testAccResourceDiscordVoiceChannel(testServerID, ""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "server_id", testServerID),
 					resource.TestCheckResourceAttr(name, "name", "terraform-voice-channel"),
@@ -29,13 +29,38 @@ func TestAccResourceDiscordVoiceChannel(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "user_limit", "4"),
 					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "false"),
 					resource.TestCheckResourceAttrSet(name, "channel_id"),
+					resource.TestCheckResourceAttr(name, "video_quality_mode", "1"),
+					resource.TestCheckResourceAttr(name, "nsfw", "false"),
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "0"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordVoiceChannel(testServerID, `
+      rtc_region = "rotterdam"
+      video_quality_mode = 2
+      nsfw = true
+      rate_limit_per_user = 30`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rtc_region", "rotterdam"),
+					resource.TestCheckResourceAttr(name, "video_quality_mode", "2"),
+					resource.TestCheckResourceAttr(name, "nsfw", "true"),
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "30"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordVoiceChannel(testServerID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rtc_region", ""),
+					resource.TestCheckResourceAttr(name, "video_quality_mode", "1"),
+					resource.TestCheckResourceAttr(name, "nsfw", "false"),
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "0"),
 				),
 			},
 		},
 	})
 }
 
-func testAccResourceDiscordVoiceChannel(serverID string) string {
+func testAccResourceDiscordVoiceChannel(serverID string, extra string) string {
 	return fmt.Sprintf(`
 	resource "discord_voice_channel" "example" {
 	  server_id = "%[1]s"
@@ -44,5 +69,6 @@ func testAccResourceDiscordVoiceChannel(serverID string) string {
       bitrate = 64000
       user_limit = 4
       sync_perms_with_category = false
-	}`, serverID)
+      %[2]s
+	}`, serverID, extra)
 }
@@ -2,9 +2,9 @@ package discord
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/polds/imgbase64"
 	"golang.org/x/net/context"
 )
 
@@ -88,7 +88,11 @@ func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	avatar := ""
 	if v, ok := d.GetOk("avatar_url"); ok {
-		avatar = imgbase64.FromRemote(v.(string))
+		img, err := fetchImage(ctx, m, v.(string))
+		if err != nil {
+			return diag.Errorf("Failed to fetch avatar: %s", err.Error())
+		}
+		avatar = img
 	}
 	if v, ok := d.GetOk("avatar_data_uri"); ok {
 		avatar = v.(string)
@@ -115,6 +119,10 @@ func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interfac
 	client := m.(*Context).Session
 
 	if webhook, err := client.Webhook(d.Id(), discordgo.WithContext(ctx)); err != nil {
+		if !isDiscordNotFound(err) {
+			return diag.Errorf("Failed to fetch webhook %s: %s", d.Id(), err.Error())
+		}
+		tflog.Warn(ctx, "Webhook not found. Removing from state", map[string]interface{}{"webhook_id": d.Id()})
 		d.SetId("")
 	} else {
 		url := "https://discord.com/api/webhooks/" + webhook.ID + "/" + webhook.Token
@@ -140,7 +148,11 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	avatar := ""
 	if v, ok := d.GetOk("avatar_url"); ok {
-		avatar = imgbase64.FromRemote(v.(string))
+		img, err := fetchImage(ctx, m, v.(string))
+		if err != nil {
+			return diag.Errorf("Failed to fetch avatar: %s", err.Error())
+		}
+		avatar = img
 	}
 	if v, ok := d.GetOk("avatar_data_uri"); ok {
 		avatar = v.(string)
@@ -166,7 +178,7 @@ func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interf
 	var diags diag.Diagnostics
 	client := m.(*Context).Session
 
-	if err := client.WebhookDelete(d.Id(), discordgo.WithContext(ctx)); err != nil {
+	if err := client.WebhookDelete(d.Id(), discordgo.WithContext(ctx)); err != nil && !isDiscordNotFound(err) {
 		return diag.FromErr(err)
 	} else {
 		return diags
@@ -13,17 +13,21 @@ func TestAccResourceDiscordWebhook(t *testing.T) {
 	if testChannelID == "" {
 		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
 	}
+	avatarURL := os.Getenv("DISCORD_TEST_AVATAR_URL")
+	if avatarURL == "" {
+		avatarURL = "https://www.terraform.io/assets/images/og-image-8b3e4f7d.png"
+	}
 	name := "discord_webhook.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceDiscordWebhook(testChannelID),
+				Config: testAccResourceDiscordWebhook(testChannelID, avatarURL),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "channel_id", testChannelID),
 					resource.TestCheckResourceAttr(name, "name", "terraform-test"),
-					resource.TestCheckResourceAttr(name, "avatar_url", "https://www.terraform.io/assets/images/og-image-8b3e4f7d.png"),
+					resource.TestCheckResourceAttr(name, "avatar_url", avatarURL),
 					resource.TestCheckResourceAttrSet(name, "token"),
 					resource.TestCheckResourceAttrSet(name, "url"),
 					resource.TestCheckResourceAttrSet(name, "slack_url"),
@@ -34,11 +38,11 @@ func TestAccResourceDiscordWebhook(t *testing.T) {
 	})
 }
 
-func testAccResourceDiscordWebhook(channelID string) string {
+func testAccResourceDiscordWebhook(channelID string, avatarURL string) string {
 	return fmt.Sprintf(`
 	resource "discord_webhook" "example" {
       channel_id = "%[1]s"
       name = "terraform-test"
-	  avatar_url = "https://www.terraform.io/assets/images/og-image-8b3e4f7d.png"
-	}`, channelID)
+	  avatar_url = "%[2]s"
+	}`, channelID, avatarURL)
 }
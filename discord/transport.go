@@ -0,0 +1,264 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiURLTransport reroutes requests aimed at the Discord REST API to another
+// base URL. Requests to any other host are passed through untouched.
+type apiURLTransport struct {
+	base string
+	next http.RoundTripper
+}
+
+func (t *apiURLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	raw := req.URL.String()
+	if !strings.HasPrefix(raw, discordgo.EndpointAPI) {
+		return t.next.RoundTrip(req)
+	}
+
+	u, err := url.Parse(t.base + strings.TrimPrefix(raw, discordgo.EndpointAPI))
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.URL = u
+	r.Host = u.Host
+
+	return t.next.RoundTrip(r)
+}
+
+// retryTransport is the backoff policy shared by every request made through
+// the provider's session. It retries rate limited requests, and the transient
+// server errors of idempotent requests, honouring Discord's Retry-After
+// header, and blocks all requests while a global rate limit is in effect.
+// Per-route buckets are still tracked by the discordgo rate limiter, which
+// holds a route's bucket for the whole retry loop.
+type retryTransport struct {
+	maxRetries    int
+	maxWait       time.Duration
+	respectGlobal bool
+	timeout       time.Duration
+	next          http.RoundTripper
+
+	mu          sync.Mutex
+	globalReset time.Time
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if err := t.waitForGlobal(ctx); err != nil {
+			return nil, err
+		}
+
+		r := req
+		if attempt > 0 && req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := t.attempt(r)
+		if err != nil || !isRetryable(req.Method, resp.StatusCode) {
+			return resp, err
+		}
+
+		wait := retryWait(resp, attempt)
+		global := resp.StatusCode == http.StatusTooManyRequests && resp.Header.Get("X-RateLimit-Global") == "true"
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"bucket":  resp.Header.Get("X-RateLimit-Bucket"),
+			"global":  global,
+		}
+		if attempt >= t.maxRetries {
+			tflog.Warn(ctx, "Discord API request failed, giving up after retries", fields)
+			return resp, nil
+		}
+		if t.maxWait > 0 && wait > t.maxWait {
+			tflog.Warn(ctx, "Discord API asked to wait longer than retry_max_wait, giving up", fields)
+			return resp, nil
+		}
+		tflog.Info(ctx, "Discord API request failed, retrying", fields)
+
+		if global && t.respectGlobal {
+			t.setGlobalReset(time.Now().Add(wait))
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt performs a single request bounded by the per-request timeout. The
+// body is buffered so the timeout can be released before the caller reads it.
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.timeout)
+		defer cancel()
+	}
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+func (t *retryTransport) waitForGlobal(ctx context.Context) error {
+	t.mu.Lock()
+	wait := time.Until(t.globalReset)
+	t.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	tflog.Debug(ctx, "Waiting for Discord global rate limit to reset", map[string]interface{}{"wait": wait.String()})
+
+	return sleepContext(ctx, wait)
+}
+
+func (t *retryTransport) setGlobalReset(reset time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if reset.After(t.globalReset) {
+		t.globalReset = reset
+	}
+}
+
+// isRetryable reports whether a request that got the status can be sent
+// again. Rate limited requests weren't processed, but a server error may come
+// after Discord made the change, so only requests that can safely be repeated
+// are retried then. Repeating a POST could create a duplicate.
+func isRetryable(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryWait returns how long to wait before retrying a failed request. The
+// Retry-After header wins when Discord sends one; otherwise the wait grows
+// exponentially from half a second.
+func retryWait(resp *http.Response, attempt int) time.Duration {
+	for _, h := range []string{"Retry-After", "X-RateLimit-Reset-After"} {
+		if v := resp.Header.Get(h); v != "" {
+			if seconds, err := strconv.ParseFloat(v, 64); err == nil && seconds >= 0 {
+				return time.Duration(seconds * float64(time.Second))
+			}
+		}
+	}
+
+	return (500 * time.Millisecond) << attempt
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// normalizeAPIURL validates a user supplied API base URL and makes sure it
+// ends with a slash, so endpoint paths can be appended to it directly.
+func normalizeAPIURL(s string) (string, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid API URL (%s): %s", s, err.Error())
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid API URL (%s), expected an absolute http or https URL", s)
+	}
+
+	return strings.TrimSuffix(u.String(), "/") + "/", nil
+}
+
+// newBaseTransport builds the HTTP transport that carries every API request,
+// applying the proxy and TLS settings from the provider configuration.
+func newBaseTransport(c *Config) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if c.ProxyURL != "" {
+		u, err := url.Parse(c.ProxyURL)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL (%s), expected an absolute URL", c.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(u)
+	}
+
+	if c.CACertPEM != "" || c.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: c.InsecureSkipVerify,
+		}
+		if c.CACertPEM != "" {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM([]byte(c.CACertPEM)) {
+				return nil, errors.New("no valid PEM certificates found in the CA bundle")
+			}
+			tlsConfig.RootCAs = pool
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return transport, nil
+}
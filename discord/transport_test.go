@@ -0,0 +1,256 @@
+package discord
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNormalizeAPIURL(t *testing.T) {
+	params := []struct {
+		in    string
+		out   string
+		isErr bool
+	}{
+		// success values
+		{in: "https://discord.com/api/v9/", out: "https://discord.com/api/v9/"},
+		{in: "http://localhost:8080/api/v9", out: "http://localhost:8080/api/v9/"},
+		{in: "http://127.0.0.1:8080", out: "http://127.0.0.1:8080/"},
+		// failure values
+		{in: "localhost:8080", isErr: true},
+		{in: "ftp://example.com/api", isErr: true},
+		{in: "/api/v9", isErr: true},
+	}
+
+	for _, p := range params {
+		out, err := normalizeAPIURL(p.in)
+		if p.isErr != (err != nil) {
+			t.Errorf("in: %v - isErr Error: ex: %v, ac: %v", p.in, p.isErr, err)
+		}
+		if p.out != out {
+			t.Errorf("in: %v - out Error: ex: %v, ac: %v", p.in, p.out, out)
+		}
+	}
+}
+
+func TestAPIURLTransport(t *testing.T) {
+	var gotPath, gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"123","name":"fake"}`))
+	}))
+	defer srv.Close()
+
+	config := Config{Token: "Bot token", APIURL: srv.URL + "/api/v9/"}
+	client, err := config.Client("test")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	guild, err := client.Session.Guild("123")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if guild.Name != "fake" {
+		t.Errorf("name Error: ex: %v, ac: %v", "fake", guild.Name)
+	}
+	if gotPath != "/api/v9/guilds/123" {
+		t.Errorf("path Error: ex: %v, ac: %v", "/api/v9/guilds/123", gotPath)
+	}
+	if gotAuth != "Bot token" {
+		t.Errorf("authorization Error: ex: %v, ac: %v", "Bot token", gotAuth)
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	params := []struct {
+		name       string
+		method     string
+		statuses   []int
+		maxRetries int
+		calls      int
+		status     int
+	}{
+		{name: "success", method: "PATCH", statuses: []int{200}, maxRetries: 3, calls: 1, status: 200},
+		{name: "rate limited once", method: "PATCH", statuses: []int{429, 200}, maxRetries: 3, calls: 2, status: 200},
+		{name: "transient errors", method: "PATCH", statuses: []int{502, 503, 200}, maxRetries: 3, calls: 3, status: 200},
+		{name: "retries exhausted", method: "PUT", statuses: []int{500, 500, 500}, maxRetries: 2, calls: 3, status: 500},
+		{name: "not retryable", method: "PATCH", statuses: []int{404, 200}, maxRetries: 3, calls: 1, status: 404},
+		{name: "create rate limited once", method: "POST", statuses: []int{429, 200}, maxRetries: 3, calls: 2, status: 200},
+		// The create may have happened, retrying it could make a duplicate.
+		{name: "create transient error", method: "POST", statuses: []int{502, 200}, maxRetries: 3, calls: 1, status: 502},
+	}
+
+	for _, p := range params {
+		calls := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			status := p.statuses[calls]
+			calls++
+			// Every retry must resend the original body.
+			if body, _ := io.ReadAll(r.Body); string(body) != `{"name":"test"}` {
+				status = http.StatusBadRequest
+			}
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			w.Write([]byte(`{}`))
+		}))
+
+		client := &http.Client{Transport: &retryTransport{
+			maxRetries: p.maxRetries,
+			maxWait:    time.Second,
+			next:       http.DefaultTransport,
+		}}
+		req, err := http.NewRequest(p.method, srv.URL, strings.NewReader(`{"name":"test"}`))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		resp, err := client.Do(req)
+		srv.Close()
+		if err != nil {
+			t.Errorf("%s - err: %s", p.name, err)
+			continue
+		}
+		if p.calls != calls {
+			t.Errorf("%s - calls Error: ex: %v, ac: %v", p.name, p.calls, calls)
+		}
+		if p.status != resp.StatusCode {
+			t.Errorf("%s - status Error: ex: %v, ac: %v", p.name, p.status, resp.StatusCode)
+		}
+	}
+}
+
+func TestRetryTransportMaxWait(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		maxRetries: 3,
+		maxWait:    time.Second,
+		next:       http.DefaultTransport,
+	}}
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls Error: ex: %v, ac: %v", 1, calls)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status Error: ex: %v, ac: %v", http.StatusTooManyRequests, resp.StatusCode)
+	}
+}
+
+func TestBaseTransportTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	params := []struct {
+		name   string
+		config Config
+		isErr  bool
+	}{
+		{name: "system roots", config: Config{}, isErr: true},
+		{name: "custom CA", config: Config{CACertPEM: caCertPEM}, isErr: false},
+		{name: "insecure", config: Config{InsecureSkipVerify: true}, isErr: false},
+	}
+
+	for _, p := range params {
+		transport, err := newBaseTransport(&p.config)
+		if err != nil {
+			t.Errorf("%s - err: %s", p.name, err)
+			continue
+		}
+		_, err = (&http.Client{Transport: transport}).Get(srv.URL)
+		if p.isErr != (err != nil) {
+			t.Errorf("%s - isErr Error: ex: %v, ac: %v", p.name, p.isErr, err)
+		}
+	}
+
+	if _, err := newBaseTransport(&Config{CACertPEM: "not a certificate"}); err == nil {
+		t.Errorf("invalid CA bundle - isErr Error: ex: %v, ac: %v", true, err)
+	}
+}
+
+func TestBaseTransportProxy(t *testing.T) {
+	var gotURL, gotAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL = r.URL.String()
+		gotAuth = r.Header.Get("Proxy-Authorization")
+		w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+
+	transport, err := newBaseTransport(&Config{ProxyURL: strings.Replace(proxy.URL, "http://", "http://user:secret@", 1)})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := (&http.Client{Transport: transport}).Get("http://discord.invalid/api/v9/gateway"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if gotURL != "http://discord.invalid/api/v9/gateway" {
+		t.Errorf("url Error: ex: %v, ac: %v", "http://discord.invalid/api/v9/gateway", gotURL)
+	}
+	if gotAuth == "" {
+		t.Errorf("proxy authorization Error: ex: %v, ac: %v", "set", gotAuth)
+	}
+}
+
+func TestBearerAuth(t *testing.T) {
+	tokens := 0
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v9/oauth2/token":
+			id, secret, ok := r.BasicAuth()
+			if !ok || id != "client" || secret != "secret" || r.FormValue("grant_type") != "client_credentials" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			tokens++
+			// An expiry inside oauth2's refresh window forces a new token per request.
+			fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":1,"scope":"%s"}`, tokens, r.FormValue("scope"))
+		default:
+			gotAuth = r.Header.Get("Authorization")
+			w.Write([]byte(`{"id":"123"}`))
+		}
+	}))
+	defer srv.Close()
+
+	config := Config{
+		ClientID: "client",
+		Secret:   "secret",
+		APIURL:   srv.URL + "/api/v9/",
+		AuthType: authTypeBearer,
+		Scopes:   []string{"applications.commands.update"},
+	}
+	client, err := config.Client("test")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for i := 1; i <= 2; i++ {
+		if _, err := client.Session.Guild("123"); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if ex := fmt.Sprintf("Bearer token-%d", i); gotAuth != ex {
+			t.Errorf("authorization Error: ex: %v, ac: %v", ex, gotAuth)
+		}
+	}
+}
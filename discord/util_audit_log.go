@@ -0,0 +1,94 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Discord rejects audit log reasons longer than this.
+const maxAuditLogReasonLength = 512
+
+type auditLogReasonKey struct{}
+
+// auditLogTransport sends the audit log reason carried by a request's context
+// as the X-Audit-Log-Reason header of every mutating request.
+type auditLogTransport struct {
+	next http.RoundTripper
+}
+
+func (t *auditLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reason, ok := req.Context().Value(auditLogReasonKey{}).(string)
+	if !ok || reason == "" || req.Method == http.MethodGet {
+		return t.next.RoundTrip(req)
+	}
+
+	r := req.Clone(req.Context())
+	r.Header.Set("X-Audit-Log-Reason", url.PathEscape(reason))
+
+	return t.next.RoundTrip(r)
+}
+
+// renderAuditLogReason expands the placeholders supported in audit log
+// reasons and trims the result to the length Discord accepts.
+func renderAuditLogReason(template string, resourceType string) string {
+	workspace := os.Getenv("TF_WORKSPACE")
+	if workspace == "" {
+		workspace = "default"
+	}
+
+	reason := strings.NewReplacer(
+		"${workspace}", workspace,
+		"${resource}", resourceType,
+	).Replace(template)
+
+	if runes := []rune(reason); len(runes) > maxAuditLogReasonLength {
+		reason = string(runes[:maxAuditLogReasonLength])
+	}
+
+	return reason
+}
+
+// withAuditLogReason adds the audit_log_reason attribute to a resource and
+// wraps its create, update and delete functions so that every request they
+// make is recorded in the audit log with the resource's reason, or the
+// provider's one if the resource doesn't set its own.
+func withAuditLogReason(resourceType string, r *schema.Resource) *schema.Resource {
+	r.Schema["audit_log_reason"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Reason recorded in the server audit log for changes made by this resource. Overrides the provider's `audit_log_reason`.",
+	}
+
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			template := m.(*Context).Config.AuditLogReason
+			if v, ok := d.GetOk("audit_log_reason"); ok {
+				template = v.(string)
+			}
+			if template != "" {
+				ctx = context.WithValue(ctx, auditLogReasonKey{}, renderAuditLogReason(template, resourceType))
+			}
+
+			return f(ctx, d, m)
+		}
+	}
+
+	// Resources without an update only need to store a changed reason.
+	if r.UpdateContext == nil {
+		r.UpdateContext = func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			return nil
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+
+	return r
+}
@@ -0,0 +1,60 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRenderAuditLogReason(t *testing.T) {
+	t.Setenv("TF_WORKSPACE", "production")
+
+	params := []struct {
+		template string
+		reason   string
+	}{
+		{template: "terraform", reason: "terraform"},
+		{template: "terraform: ${workspace}", reason: "terraform: production"},
+		{template: "${resource} (${workspace})", reason: "discord_role (production)"},
+		{template: strings.Repeat("a", 600), reason: strings.Repeat("a", maxAuditLogReasonLength)},
+	}
+
+	for _, p := range params {
+		if reason := renderAuditLogReason(p.template, "discord_role"); p.reason != reason {
+			t.Errorf("template: %v - reason Error: ex: %v, ac: %v", p.template, p.reason, reason)
+		}
+	}
+}
+
+func TestAuditLogTransport(t *testing.T) {
+	var gotReason string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReason = r.Header.Get("X-Audit-Log-Reason")
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &auditLogTransport{next: http.DefaultTransport}}
+	ctx := context.WithValue(context.Background(), auditLogReasonKey{}, "terraform: déploiement")
+
+	params := []struct {
+		method string
+		reason string
+	}{
+		{method: http.MethodGet, reason: ""},
+		{method: http.MethodPost, reason: "terraform:%20d%C3%A9ploiement"},
+		{method: http.MethodDelete, reason: "terraform:%20d%C3%A9ploiement"},
+	}
+
+	for _, p := range params {
+		gotReason = ""
+		req, _ := http.NewRequestWithContext(ctx, p.method, srv.URL, nil)
+		if _, err := client.Do(req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if p.reason != gotReason {
+			t.Errorf("method: %v - reason Error: ex: %v, ac: %v", p.method, p.reason, gotReason)
+		}
+	}
+}
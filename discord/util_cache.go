@@ -0,0 +1,281 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// apiCache deduplicates the server, role list and channel list fetches made
+// by the resources of a single Terraform operation. Terraform configures a
+// new provider for every plan and apply, so nothing cached outlives the
+// operation that fetched it. Every mutating request sent through the session
+// invalidates the entries it may have changed, see cacheTransport.
+type apiCache struct {
+	mu       sync.Mutex
+	guilds   map[string]*cacheEntry
+	roles    map[string]*cacheEntry
+	channels map[string]*cacheEntry
+	// channelServers maps every channel seen in a channel list to its server,
+	// so that requests to a channel invalidate the right list.
+	channelServers map[string]string
+}
+
+// cacheKind selects the entries of an apiCache a lookup is made in.
+type cacheKind int
+
+const (
+	cacheGuilds cacheKind = iota
+	cacheRoles
+	cacheChannels
+)
+
+// cacheEntry is a fetch in progress or its result. Concurrent lookups of the
+// same key wait for the first fetch instead of making their own.
+type cacheEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func newAPICache() *apiCache {
+	return &apiCache{
+		guilds:         map[string]*cacheEntry{},
+		roles:          map[string]*cacheEntry{},
+		channels:       map[string]*cacheEntry{},
+		channelServers: map[string]string{},
+	}
+}
+
+// entries returns the entries of a kind. The caller must hold c.mu.
+func (c *apiCache) entries(kind cacheKind) map[string]*cacheEntry {
+	switch kind {
+	case cacheGuilds:
+		return c.guilds
+	case cacheRoles:
+		return c.roles
+	default:
+		return c.channels
+	}
+}
+
+// get returns the cached value of key, calling fetch if there is none.
+// Failed fetches aren't cached.
+func (c *apiCache) get(ctx context.Context, kind cacheKind, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	entries := c.entries(kind)
+	if e, ok := entries[key]; ok {
+		c.mu.Unlock()
+
+		select {
+		case <-e.done:
+			return e.value, e.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	e := &cacheEntry{done: make(chan struct{})}
+	entries[key] = e
+	c.mu.Unlock()
+
+	e.value, e.err = fetch()
+	close(e.done)
+
+	if e.err != nil {
+		c.mu.Lock()
+		if entries := c.entries(kind); entries[key] == e {
+			delete(entries, key)
+		}
+		c.mu.Unlock()
+	}
+
+	return e.value, e.err
+}
+
+// serverOf returns the server of a channel seen in a cached channel list.
+func (c *apiCache) serverOf(channelId string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	serverId, ok := c.channelServers[channelId]
+	return serverId, ok
+}
+
+// invalidate drops the entries a request with the given method to the given
+// API path, relative to the API's base URL, may have changed.
+func (c *apiCache) invalidate(method string, path string) {
+	if method == http.MethodGet || method == http.MethodHead {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch parts[0] {
+	case "guilds":
+		if len(parts) < 2 {
+			// Creating a server doesn't change any existing one.
+			return
+		}
+		serverId := parts[1]
+
+		if len(parts) == 2 {
+			delete(c.guilds, serverId)
+			if method == http.MethodDelete {
+				delete(c.roles, serverId)
+				delete(c.channels, serverId)
+			}
+			return
+		}
+
+		switch parts[2] {
+		case "roles":
+			// Servers are fetched with their roles.
+			delete(c.guilds, serverId)
+			delete(c.roles, serverId)
+		case "channels":
+			delete(c.channels, serverId)
+		case "members", "bans", "invites", "webhooks":
+		default:
+			delete(c.guilds, serverId)
+		}
+	case "channels":
+		if len(parts) < 2 {
+			c.clear()
+			return
+		}
+		if len(parts) > 2 {
+			switch parts[2] {
+			case "messages", "pins", "invites", "webhooks", "followers", "typing":
+				return
+			}
+		}
+
+		if serverId, ok := c.channelServers[parts[1]]; ok {
+			delete(c.channels, serverId)
+		} else {
+			// The channel isn't in any cached list as far as we know, but
+			// a list being fetched right now may still include it.
+			clear(c.channels)
+		}
+	case "webhooks", "invites", "oauth2":
+	default:
+		c.clear()
+	}
+}
+
+// clear drops every entry. The maps are emptied in place, as lookups in
+// progress may still hold them.
+func (c *apiCache) clear() {
+	clear(c.guilds)
+	clear(c.roles)
+	clear(c.channels)
+}
+
+// cacheTransport invalidates the provider's cache after every mutating
+// request to the Discord API, whether or not it succeeded.
+type cacheTransport struct {
+	cache *apiCache
+	next  http.RoundTripper
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+
+	if raw := req.URL.String(); strings.HasPrefix(raw, discordgo.EndpointAPI) {
+		path, _, _ := strings.Cut(strings.TrimPrefix(raw, discordgo.EndpointAPI), "?")
+		t.cache.invalidate(req.Method, path)
+	}
+
+	return resp, err
+}
+
+// getGuild returns a server, fetching it at most once per operation.
+func (c *Context) getGuild(ctx context.Context, serverId string) (*discordgo.Guild, error) {
+	if c.cache == nil {
+		return c.Session.Guild(serverId, discordgo.WithContext(ctx))
+	}
+
+	v, err := c.cache.get(ctx, cacheGuilds, serverId, func() (interface{}, error) {
+		return c.Session.Guild(serverId, discordgo.WithContext(ctx))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	server := *v.(*discordgo.Guild)
+	server.Roles = append([]*discordgo.Role(nil), server.Roles...)
+	return &server, nil
+}
+
+// getGuildRoles returns the roles of a server, fetching them at most once per
+// operation. The returned slice may be reordered freely.
+func (c *Context) getGuildRoles(ctx context.Context, serverId string) ([]*discordgo.Role, error) {
+	if c.cache == nil {
+		return c.Session.GuildRoles(serverId, discordgo.WithContext(ctx))
+	}
+
+	v, err := c.cache.get(ctx, cacheRoles, serverId, func() (interface{}, error) {
+		return c.Session.GuildRoles(serverId, discordgo.WithContext(ctx))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*discordgo.Role(nil), v.([]*discordgo.Role)...), nil
+}
+
+// getGuildChannels returns the channels of a server, fetching them at most
+// once per operation. The returned slice may be reordered freely.
+func (c *Context) getGuildChannels(ctx context.Context, serverId string) ([]*apiChannel, error) {
+	if c.cache == nil {
+		return fetchServerChannels(ctx, c.Session, serverId)
+	}
+
+	v, err := c.cache.get(ctx, cacheChannels, serverId, func() (interface{}, error) {
+		channels, err := fetchServerChannels(ctx, c.Session, serverId)
+		if err != nil {
+			return nil, err
+		}
+
+		c.cache.mu.Lock()
+		for _, channel := range channels {
+			c.cache.channelServers[channel.ID] = serverId
+		}
+		c.cache.mu.Unlock()
+
+		return channels, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*apiChannel(nil), v.([]*apiChannel)...), nil
+}
+
+// getChannel returns a channel, looking it up in the cached channel list of
+// its server when the server is known. Channels missing from the list, such
+// as threads, are fetched on their own.
+func (c *Context) getChannel(ctx context.Context, serverId string, channelId string) (*apiChannel, error) {
+	if serverId == "" && c.cache != nil {
+		serverId, _ = c.cache.serverOf(channelId)
+	}
+
+	if serverId != "" {
+		if channels, err := c.getGuildChannels(ctx, serverId); err == nil {
+			for _, channel := range channels {
+				if channel.ID == channelId {
+					ch := *channel
+					return &ch, nil
+				}
+			}
+		}
+	}
+
+	return fetchChannel(ctx, c.Session, channelId)
+}
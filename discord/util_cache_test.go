@@ -0,0 +1,209 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestAPICacheInvalidate(t *testing.T) {
+	params := []struct {
+		Method   string
+		Path     string
+		Guilds   bool
+		Roles    bool
+		Channels bool
+	}{
+		{"GET", "guilds/1", true, true, true},
+		{"POST", "guilds", true, true, true},
+		{"PATCH", "guilds/1", false, true, true},
+		{"DELETE", "guilds/1", false, false, false},
+		{"PATCH", "guilds/2", true, true, true},
+		{"POST", "guilds/1/roles", false, false, true},
+		{"PATCH", "guilds/1/roles/5", false, false, true},
+		{"PATCH", "guilds/1/channels", true, true, false},
+		{"POST", "guilds/1/channels", true, true, false},
+		{"PUT", "guilds/1/members/7/roles/5", true, true, true},
+		{"PUT", "guilds/1/onboarding", false, true, true},
+		{"PATCH", "channels/10", true, true, false},
+		{"PUT", "channels/10/permissions/5", true, true, false},
+		{"DELETE", "channels/10", true, true, false},
+		{"POST", "channels/10/messages", true, true, true},
+		{"PATCH", "channels/99", true, true, false},
+		{"DELETE", "webhooks/3", true, true, true},
+		{"DELETE", "users/@me/guilds/1", false, false, false},
+	}
+
+	for _, p := range params {
+		cache := newAPICache()
+		for _, entries := range []map[string]*cacheEntry{cache.guilds, cache.roles, cache.channels} {
+			entries["1"] = &cacheEntry{}
+		}
+		cache.channelServers["10"] = "1"
+
+		cache.invalidate(p.Method, p.Path)
+
+		if _, ok := cache.guilds["1"]; ok != p.Guilds {
+			t.Errorf("%s %s guilds Error: ex: %v, ac: %v", p.Method, p.Path, p.Guilds, ok)
+		}
+		if _, ok := cache.roles["1"]; ok != p.Roles {
+			t.Errorf("%s %s roles Error: ex: %v, ac: %v", p.Method, p.Path, p.Roles, ok)
+		}
+		if _, ok := cache.channels["1"]; ok != p.Channels {
+			t.Errorf("%s %s channels Error: ex: %v, ac: %v", p.Method, p.Path, p.Channels, ok)
+		}
+	}
+}
+
+func TestAPICacheConcurrentInvalidate(t *testing.T) {
+	cache := newAPICache()
+	ctx := context.Background()
+	fetch := func() (interface{}, error) { return "value", nil }
+
+	// Run with -race: lookups must not race with invalidations.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for _, kind := range []cacheKind{cacheGuilds, cacheRoles, cacheChannels} {
+					if _, err := cache.get(ctx, kind, "1", fetch); err != nil {
+						t.Errorf("err: %s", err)
+					}
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				cache.invalidate("DELETE", "channels/99")
+				cache.invalidate("PATCH", "guilds/1")
+				cache.invalidate("POST", "users/@me/channels")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// A fetch in progress when its entry is invalidated isn't cached.
+	fetches := 0
+	started, release := make(chan struct{}), make(chan struct{})
+	go func() {
+		<-started
+		cache.invalidate("DELETE", "channels/99")
+		close(release)
+	}()
+	for i := 0; i < 2; i++ {
+		cache.get(ctx, cacheChannels, "2", func() (interface{}, error) {
+			fetches++
+			if fetches == 1 {
+				close(started)
+				<-release
+			}
+			return "value", nil
+		})
+	}
+	if fetches != 2 {
+		t.Errorf("fetches Error: ex: %v, ac: %v", 2, fetches)
+	}
+}
+
+func TestContextCache(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v9/guilds/1":
+			w.Write([]byte(`{"id":"1","name":"fake","roles":[{"id":"1","name":"@everyone"}]}`))
+		case "/api/v9/guilds/1/roles":
+			w.Write([]byte(`[{"id":"1","name":"@everyone"},{"id":"2","name":"role"}]`))
+		case "/api/v9/guilds/1/channels":
+			w.Write([]byte(`[{"id":"10","guild_id":"1","name":"general"}]`))
+		case "/api/v9/channels/10", "/api/v9/channels/11":
+			w.Write([]byte(`{"id":"` + r.URL.Path[len("/api/v9/channels/"):] + `","guild_id":"1"}`))
+		case "/api/v9/guilds/1/roles/2":
+			w.Write([]byte(`{"id":"2","name":"renamed"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":0,"message":"404: Not Found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	config := Config{Token: "Bot token", APIURL: srv.URL + "/api/v9/"}
+	client, err := config.Client("test")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.getGuild(ctx, "1"); err != nil {
+				t.Errorf("err: %s", err)
+			}
+			if _, err := client.getGuildRoles(ctx, "1"); err != nil {
+				t.Errorf("err: %s", err)
+			}
+			if _, err := client.getChannel(ctx, "1", "10"); err != nil {
+				t.Errorf("err: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Channels missing from the list are fetched on their own.
+	if _, err := client.getChannel(ctx, "1", "11"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	// Channels seen in a list don't need their server.
+	if _, err := client.getChannel(ctx, "", "10"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := client.Session.GuildRoleEdit("1", "2", &discordgo.RoleParams{Name: "renamed"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := client.getGuild(ctx, "1"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := client.getGuildRoles(ctx, "1"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := client.getGuildChannels(ctx, "1"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Failed fetches aren't cached.
+	for i := 0; i < 2; i++ {
+		if _, err := client.getGuild(ctx, "2"); err == nil {
+			t.Fatalf("expected an error fetching an unknown server")
+		}
+	}
+
+	expected := map[string]int{
+		"GET /api/v9/guilds/1":          2,
+		"GET /api/v9/guilds/1/roles":    2,
+		"GET /api/v9/guilds/1/channels": 1,
+		"GET /api/v9/channels/10":       0,
+		"GET /api/v9/channels/11":       1,
+		"GET /api/v9/guilds/2":          2,
+	}
+	for request, ex := range expected {
+		if ac := requests[request]; ac != ex {
+			t.Errorf("%s requests Error: ex: %v, ac: %v", request, ex, ac)
+		}
+	}
+}
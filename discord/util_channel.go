@@ -2,8 +2,13 @@ package discord
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func getTextChannelType(channelType discordgo.ChannelType) (string, bool) {
@@ -18,8 +23,12 @@ func getTextChannelType(channelType discordgo.ChannelType) (string, bool) {
 		return "news", true
 	case 6:
 		return "store", true
+	case 13:
+		return "stage", true
 	case 15:
 		return "forum", true
+	case 16:
+		return "media", true
 	}
 
 	return "text", false
@@ -37,13 +46,269 @@ func getDiscordChannelType(name string) (discordgo.ChannelType, bool) {
 		return discordgo.ChannelTypeGuildNews, true
 	case "store":
 		return discordgo.ChannelTypeGuildStore, true
+	case "stage":
+		return discordgo.ChannelTypeGuildStageVoice, true
 	case "forum":
 		return discordgo.ChannelTypeGuildForum, true
+	case "media":
+		return discordgo.ChannelTypeGuildMedia, true
 	}
 
 	return 0, false
 }
 
+// Video quality modes of voice channels.
+const (
+	videoQualityModeAuto = 1
+	videoQualityModeFull = 2
+)
+
+// textChannelSchema returns the settings of text and news channels.
+func textChannelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"topic": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Topic of the channel.",
+		},
+		"nsfw": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the channel is NSFW.",
+		},
+		"rate_limit_per_user": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 21600),
+			Description:  "Slowmode of the channel: the number of seconds a member has to wait between two messages.",
+		},
+		"default_auto_archive_duration": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntInSlice([]int{60, 1440, 4320, 10080}),
+			Description:  "Default number of minutes of inactivity after which threads created in the channel are archived. One of `60`, `1440`, `4320` or `10080`.",
+		},
+		"default_thread_rate_limit_per_user": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 21600),
+			Description:  "Slowmode, in seconds, of the threads created in the channel.",
+		},
+	}
+}
+
+// apiChannel is a channel as returned by the API, including the fields
+// discordgo doesn't know about.
+type apiChannel struct {
+	discordgo.Channel
+	RTCRegion                  *string `json:"rtc_region"`
+	DefaultAutoArchiveDuration int     `json:"default_auto_archive_duration"`
+	VideoQualityMode           int     `json:"video_quality_mode"`
+}
+
+// channelCreateData is discordgo.GuildChannelCreateData with the fields it
+// lacks.
+type channelCreateData struct {
+	discordgo.GuildChannelCreateData
+	RTCRegion                     string                          `json:"rtc_region,omitempty"`
+	AvailableTags                 []discordgo.ForumTag            `json:"available_tags,omitempty"`
+	DefaultReactionEmoji          *discordgo.ForumDefaultReaction `json:"default_reaction_emoji,omitempty"`
+	DefaultSortOrder              *int                            `json:"default_sort_order,omitempty"`
+	DefaultForumLayout            int                             `json:"default_forum_layout,omitempty"`
+	DefaultThreadRateLimitPerUser int                             `json:"default_thread_rate_limit_per_user,omitempty"`
+	DefaultAutoArchiveDuration    int                             `json:"default_auto_archive_duration,omitempty"`
+	VideoQualityMode              int                             `json:"video_quality_mode,omitempty"`
+}
+
+// channelEditData is discordgo.ChannelEdit with the fields it lacks. Fields
+// of type json.RawMessage are only sent when set, and may be set to null.
+// PermissionOverwrites and Type are sent whenever they are set, even to an
+// empty list or to the type of text channels, which is 0.
+type channelEditData struct {
+	discordgo.ChannelEdit
+	Type                       *discordgo.ChannelType            `json:"type,omitempty"`
+	PermissionOverwrites       *[]*discordgo.PermissionOverwrite `json:"permission_overwrites,omitempty"`
+	RTCRegion                  json.RawMessage                   `json:"rtc_region,omitempty"`
+	DefaultReactionEmoji       json.RawMessage                   `json:"default_reaction_emoji,omitempty"`
+	DefaultAutoArchiveDuration int                               `json:"default_auto_archive_duration,omitempty"`
+	VideoQualityMode           int                               `json:"video_quality_mode,omitempty"`
+}
+
+// nullableString encodes s as a JSON string, or as null if it is empty.
+func nullableString(s string) json.RawMessage {
+	if s == "" {
+		return json.RawMessage("null")
+	}
+
+	b, _ := json.Marshal(s)
+	return b
+}
+
+func channelRequest(ctx context.Context, client *discordgo.Session, method string, url string, bucket string, data interface{}, v interface{}) error {
+	body, err := client.RequestWithBucketID(method, url, data, bucket, discordgo.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+func fetchChannel(ctx context.Context, client *discordgo.Session, channelId string) (*apiChannel, error) {
+	var channel *apiChannel
+	endpoint := discordgo.EndpointChannel(channelId)
+	if err := channelRequest(ctx, client, "GET", endpoint, endpoint, nil, &channel); err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+func fetchServerChannels(ctx context.Context, client *discordgo.Session, serverId string) ([]*apiChannel, error) {
+	var channels []*apiChannel
+	endpoint := discordgo.EndpointGuildChannels(serverId)
+	if err := channelRequest(ctx, client, "GET", endpoint, endpoint, nil, &channels); err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+func createChannel(ctx context.Context, client *discordgo.Session, serverId string, data channelCreateData) (*apiChannel, error) {
+	var channel *apiChannel
+	endpoint := discordgo.EndpointGuildChannels(serverId)
+	if err := channelRequest(ctx, client, "POST", endpoint, endpoint, data, &channel); err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+func editChannel(ctx context.Context, client *discordgo.Session, channelId string, data channelEditData) (*apiChannel, error) {
+	var channel *apiChannel
+	endpoint := discordgo.EndpointChannel(channelId)
+	if err := channelRequest(ctx, client, "PATCH", endpoint, endpoint, data, &channel); err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+// channelPosition is an entry of a request reordering the channels of a
+// server. Unlike discordgo's GuildChannelsReorder, it can move a channel to
+// another category.
+type channelPosition struct {
+	ID       string          `json:"id"`
+	Position int             `json:"position"`
+	ParentID json.RawMessage `json:"parent_id,omitempty"`
+}
+
+func reorderChannels(ctx context.Context, client *discordgo.Session, serverId string, positions []channelPosition) error {
+	endpoint := discordgo.EndpointGuildChannels(serverId)
+	_, err := client.RequestWithBucketID("PATCH", endpoint, positions, endpoint, discordgo.WithContext(ctx))
+	return err
+}
+
+// channelSortGroup returns the group a channel is listed in within its
+// category, as Discord lists voice and stage channels below the other ones.
+func channelSortGroup(channel *apiChannel) int {
+	if channel.Type == discordgo.ChannelTypeGuildVoice || channel.Type == discordgo.ChannelTypeGuildStageVoice {
+		return 1
+	}
+	return 0
+}
+
+// sortChannelsByPosition sorts channels the way Discord lists them.
+func sortChannelsByPosition(channels []*apiChannel) {
+	sort.SliceStable(channels, func(i, j int) bool {
+		if gi, gj := channelSortGroup(channels[i]), channelSortGroup(channels[j]); gi != gj {
+			return gi < gj
+		}
+		if channels[i].Position != channels[j].Position {
+			return channels[i].Position < channels[j].Position
+		}
+		return channels[i].ID < channels[j].ID
+	})
+}
+
+// channelSiblings returns the channels listed alongside the channel, in the
+// order Discord lists them: the channels of its category, or every category
+// for a category.
+func channelSiblings(channels []*apiChannel, channel *apiChannel) []*apiChannel {
+	isCategory := channel.Type == discordgo.ChannelTypeGuildCategory
+
+	var siblings []*apiChannel
+	for _, c := range channels {
+		if (c.Type == discordgo.ChannelTypeGuildCategory) != isCategory {
+			continue
+		}
+		if isCategory || c.ParentID == channel.ParentID {
+			siblings = append(siblings, c)
+		}
+	}
+	sortChannelsByPosition(siblings)
+
+	return siblings
+}
+
+// placeChannel moves a channel right after or before another channel of its
+// category, renumbering the channels of the category that need it.
+func placeChannel(ctx context.Context, c *Context, serverId string, channelId string, afterId string, beforeId string) error {
+	channels, err := c.getGuildChannels(ctx, serverId)
+	if err != nil {
+		return err
+	}
+
+	var channel *apiChannel
+	for _, ch := range channels {
+		if ch.ID == channelId {
+			channel = ch
+		}
+	}
+	if channel == nil {
+		return fmt.Errorf("channel %s not found", channelId)
+	}
+
+	referenceId := afterId + beforeId
+	siblings := channelSiblings(channels, channel)
+	order := make([]*apiChannel, 0, len(siblings))
+	for _, sibling := range siblings {
+		if sibling.ID == channelId {
+			continue
+		}
+		if sibling.ID == referenceId && afterId == "" {
+			order = append(order, channel)
+		}
+		order = append(order, sibling)
+		if sibling.ID == referenceId && afterId != "" {
+			order = append(order, channel)
+		}
+	}
+	if len(order) != len(siblings) {
+		return fmt.Errorf("channel %s is not in the same category as channel %s", referenceId, channelId)
+	}
+
+	// Discord lists voice and stage channels below the other ones whatever
+	// their position.
+	var positions []channelPosition
+	for i, ch := range order {
+		if i > 0 && channelSortGroup(ch) < channelSortGroup(order[i-1]) {
+			return fmt.Errorf("channel %s can't be placed there, as Discord lists voice and stage channels below the other channels of a category", channelId)
+		}
+		if ch.Position != i {
+			positions = append(positions, channelPosition{ID: ch.ID, Position: i})
+		}
+	}
+	if len(positions) == 0 {
+		return nil
+	}
+
+	return reorderChannels(ctx, c.Session, serverId, positions)
+}
+
 type Channel struct {
 	ServerId  string
 	ChannelId string
@@ -92,7 +357,7 @@ func arePermissionsSynced(from *discordgo.Channel, to *discordgo.Channel) bool {
 
 func syncChannelPermissions(c *discordgo.Session, ctx context.Context, from *discordgo.Channel, to *discordgo.Channel) error {
 	for _, p := range to.PermissionOverwrites {
-		if err := c.ChannelPermissionDelete(to.ID, p.ID); err != nil {
+		if err := c.ChannelPermissionDelete(to.ID, p.ID, discordgo.WithContext(ctx)); err != nil {
 			return err
 		}
 	}
@@ -116,3 +381,80 @@ func getDiscordChannelPermissionType(value string) (discordgo.PermissionOverwrit
 		return 0, false
 	}
 }
+
+func getTextChannelPermissionType(value discordgo.PermissionOverwriteType) (string, bool) {
+	switch value {
+	case discordgo.PermissionOverwriteTypeRole:
+		return "role", true
+	case discordgo.PermissionOverwriteTypeMember:
+		return "user", true
+	default:
+		return "", false
+	}
+}
+
+// permissionOverwriteResource returns the schema of a permission overwrite
+// in a set that replaces every overwrite of a channel.
+func permissionOverwriteResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"role", "user"}, false),
+				Description:  "Type of the overwrite. Must be `role` or `user`.",
+			},
+			"overwrite_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the role or user the overwrite applies to.",
+			},
+			"allow": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Permission bits allowed by the overwrite.",
+			},
+			"deny": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Permission bits denied by the overwrite.",
+			},
+		},
+	}
+}
+
+func buildPermissionOverwrites(set *schema.Set) []*discordgo.PermissionOverwrite {
+	overwrites := make([]*discordgo.PermissionOverwrite, 0, set.Len())
+	for _, o := range set.List() {
+		overwrite := o.(map[string]interface{})
+		overwriteType, _ := getDiscordChannelPermissionType(overwrite["type"].(string))
+		overwrites = append(overwrites, &discordgo.PermissionOverwrite{
+			ID:    overwrite["overwrite_id"].(string),
+			Type:  overwriteType,
+			Allow: int64(overwrite["allow"].(int)),
+			Deny:  int64(overwrite["deny"].(int)),
+		})
+	}
+
+	return overwrites
+}
+
+func flattenPermissionOverwrites(overwrites []*discordgo.PermissionOverwrite) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(overwrites))
+	for _, o := range overwrites {
+		overwriteType, ok := getTextChannelPermissionType(o.Type)
+		if !ok {
+			continue
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"type":         overwriteType,
+			"overwrite_id": o.ID,
+			"allow":        int(o.Allow),
+			"deny":         int(o.Deny),
+		})
+	}
+
+	return flattened
+}
@@ -18,6 +18,8 @@ func TestGetTextChannelType(t *testing.T) {
 		{id: 4, chType: "category", isHit: true},
 		{id: 5, chType: "news", isHit: true},
 		{id: 6, chType: "store", isHit: true},
+		{id: 13, chType: "stage", isHit: true},
+		{id: 16, chType: "media", isHit: true},
 		// failure values
 		{id: 10, chType: "text", isHit: false},
 		{id: 100, chType: "text", isHit: false},
@@ -47,6 +49,8 @@ func TestGetDiscordChannelType(t *testing.T) {
 		{chType: 4, name: "category", isHit: true},
 		{chType: 5, name: "news", isHit: true},
 		{chType: 6, name: "store", isHit: true},
+		{chType: 13, name: "stage", isHit: true},
+		{chType: 16, name: "media", isHit: true},
 		// failure values
 		{chType: 0, name: "lorem", isHit: false},
 		{chType: 0, name: "pesudo", isHit: false},
@@ -0,0 +1,45 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deletionProtectionSchema returns the schema of a resource's
+// deletion_protection, which falls back to the provider's default.
+func deletionProtectionSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Whether Terraform is prevented from deleting the " + resource + ". It has to be set to `false` and applied before the " + resource + " can be destroyed or replaced. Defaults to the provider's `deletion_protection`.",
+	}
+}
+
+// resourceDeletionProtectionCustomizeDiff resolves an unset
+// deletion_protection to the provider's default during plan.
+func resourceDeletionProtectionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.GetAttr("deletion_protection").IsNull() {
+		return nil
+	}
+
+	deletionProtection := m.(*Context).Config.DeletionProtection
+	if v, ok := d.GetOk("deletion_protection"); ok && v.(bool) == deletionProtection {
+		return nil
+	}
+
+	return d.SetNew("deletion_protection", deletionProtection)
+}
+
+// checkDeletionProtection fails the deletion of a resource that has
+// deletion_protection enabled.
+func checkDeletionProtection(d *schema.ResourceData, resource string) diag.Diagnostics {
+	if !d.Get("deletion_protection").(bool) {
+		return nil
+	}
+
+	return diag.Errorf("Cannot delete %s %s: deletion_protection is enabled. Set deletion_protection to false and apply before deleting it.", resource, d.Id())
+}
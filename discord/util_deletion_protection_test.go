@@ -0,0 +1,25 @@
+package discord
+
+import (
+	"testing"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	params := []struct {
+		deletionProtection bool
+		isErr              bool
+	}{
+		{deletionProtection: false, isErr: false},
+		{deletionProtection: true, isErr: true},
+	}
+
+	for _, p := range params {
+		d := resourceDiscordRole().TestResourceData()
+		d.SetId("123")
+		d.Set("deletion_protection", p.deletionProtection)
+
+		if diags := checkDeletionProtection(d, "role"); p.isErr != diags.HasError() {
+			t.Errorf("deletion_protection: %v - isErr Error: ex: %v, ac: %v", p.deletionProtection, p.isErr, diags)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type UnmappedEmbed struct {
@@ -21,6 +22,218 @@ type UnmappedEmbed struct {
 	Fields      []*discordgo.MessageEmbedField     `json:"fields,omitempty"`      //	array of embed field objects	fields information
 }
 
+// embedSchema returns the schema of an embed block, as read by buildEmbed.
+func embedSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Title of the embed.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the embed.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the embed.",
+			},
+			"timestamp": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Timestamp of the embed content.",
+			},
+			"color": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Color of the embed. Must be an integer color code.",
+			},
+			"footer": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Footer of the embed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"text": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Text of the footer.",
+						},
+						"icon_url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URL to an icon to be included in the footer.",
+						},
+					},
+				},
+			},
+			"image": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Image to be included in the embed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the image to be included in the embed.",
+						},
+						"proxy_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL to access the image via Discord's proxy.",
+						},
+						"height": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Height of the image.",
+						},
+						"width": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Width of the image.",
+						},
+					},
+				},
+			},
+			"thumbnail": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Thumbnail to be included in the embed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the thumbnail to be included in the embed.",
+						},
+						"proxy_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL to access the thumbnail via Discord's proxy.",
+						},
+						"height": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Height of the thumbnail.",
+						},
+						"width": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Width of the thumbnail.",
+						},
+					},
+				},
+			},
+			"video": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Video to be included in the embed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the video to be included in the embed.",
+						},
+						"height": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Height of the video.",
+						},
+						"width": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Width of the video.",
+						},
+					},
+				},
+			},
+			"provider": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Provider of the embed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the provider.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URL of the provider.",
+						},
+					},
+				},
+			},
+			"author": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Author of the embed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the author.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URL of the author.",
+						},
+						"icon_url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URL of the author's icon.",
+						},
+						"proxy_icon_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL to access the author's icon via Discord's proxy.",
+						},
+					},
+				},
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Fields of the embed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the field.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of the field.",
+						},
+						"inline": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the field is inline.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func buildEmbed(embedList []interface{}) (*discordgo.MessageEmbed, error) {
 	embedMap := embedList[0].(map[string]interface{})
 
@@ -0,0 +1,271 @@
+package discord
+
+import (
+	"encoding/json"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// channelFlagHideMediaDownloadOptions hides the embedded media download
+// options of a media channel. discordgo doesn't define it yet.
+const channelFlagHideMediaDownloadOptions discordgo.ChannelFlags = 1 << 15
+
+// isForumChannelType reports whether channels of a type hold posts, and so
+// have the settings of forumChannelSchema.
+func isForumChannelType(channelType string) bool {
+	return channelType == "forum" || channelType == "media"
+}
+
+// forumChannelSchema returns the settings shared by forum and media channels.
+func forumChannelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"default_sort_order": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 1),
+			Description:  "Default order of posts in the channel. 0 = Latest Activity, 1 = Creation Date.",
+		},
+		"default_thread_rate_limit_per_user": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 21600),
+			Description:  "Slowmode, in seconds, of the posts created in the channel.",
+		},
+		"require_tag": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether posts in the channel must have at least one tag.",
+		},
+		"available_tag": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    20,
+			Description: "Tags that can be applied to posts in the channel. A tag keeps its ID when it is renamed in place, so existing posts keep it.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the tag.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the tag.",
+					},
+					"moderated": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether only members with the Manage Threads permission can apply the tag.",
+					},
+					"emoji_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "ID of a custom emoji shown with the tag.",
+					},
+					"emoji_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Unicode emoji shown with the tag.",
+					},
+				},
+			},
+		},
+		"default_reaction_emoji": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Emoji shown in the add reaction button of posts in the channel.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"emoji_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "ID of a custom emoji.",
+					},
+					"emoji_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Unicode emoji.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildForumTags returns the configured tags of a channel. Each tag keeps the
+// ID of the current tag with the same name, or failing that, of the current
+// tag at the same position, so that renaming a tag doesn't replace it.
+func buildForumTags(d *schema.ResourceData, current []discordgo.ForumTag) []discordgo.ForumTag {
+	configured := d.Get("available_tag").([]interface{})
+	tags := make([]discordgo.ForumTag, len(configured))
+	used := map[string]bool{}
+
+	for i, t := range configured {
+		tag := t.(map[string]interface{})
+		tags[i] = discordgo.ForumTag{
+			Name:      tag["name"].(string),
+			Moderated: tag["moderated"].(bool),
+			EmojiID:   tag["emoji_id"].(string),
+			EmojiName: tag["emoji_name"].(string),
+		}
+
+		for _, c := range current {
+			if c.Name == tags[i].Name && !used[c.ID] {
+				tags[i].ID = c.ID
+				used[c.ID] = true
+				break
+			}
+		}
+	}
+
+	for i := range tags {
+		if tags[i].ID == "" && i < len(current) && !used[current[i].ID] {
+			tags[i].ID = current[i].ID
+			used[current[i].ID] = true
+		}
+	}
+
+	return tags
+}
+
+func flattenForumTags(tags []discordgo.ForumTag) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(tags))
+	for _, t := range tags {
+		flattened = append(flattened, map[string]interface{}{
+			"id":         t.ID,
+			"name":       t.Name,
+			"moderated":  t.Moderated,
+			"emoji_id":   t.EmojiID,
+			"emoji_name": t.EmojiName,
+		})
+	}
+
+	return flattened
+}
+
+// buildDefaultReaction returns the configured default reaction of a channel,
+// or nil if there is none.
+func buildDefaultReaction(d *schema.ResourceData) *discordgo.ForumDefaultReaction {
+	v, ok := d.GetOk("default_reaction_emoji")
+	if !ok || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	emoji := v.([]interface{})[0].(map[string]interface{})
+	return &discordgo.ForumDefaultReaction{
+		EmojiID:   emoji["emoji_id"].(string),
+		EmojiName: emoji["emoji_name"].(string),
+	}
+}
+
+// defaultReactionJSON encodes a default reaction for an edit, which needs an
+// explicit null to remove it.
+func defaultReactionJSON(reaction *discordgo.ForumDefaultReaction) json.RawMessage {
+	if reaction == nil {
+		return json.RawMessage("null")
+	}
+
+	b, _ := json.Marshal(reaction)
+	return b
+}
+
+func flattenDefaultReaction(reaction discordgo.ForumDefaultReaction) []map[string]interface{} {
+	if reaction.EmojiID == "" && reaction.EmojiName == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"emoji_id":   reaction.EmojiID,
+		"emoji_name": reaction.EmojiName,
+	}}
+}
+
+// forumChannelFlags returns the flags of a channel with the ones managed by
+// its configuration replaced.
+func forumChannelFlags(d *schema.ResourceData, channelType string, current discordgo.ChannelFlags) discordgo.ChannelFlags {
+	flags := current &^ (discordgo.ChannelFlagRequireTag | channelFlagHideMediaDownloadOptions)
+	if d.Get("require_tag").(bool) {
+		flags |= discordgo.ChannelFlagRequireTag
+	}
+	if channelType == "media" && d.Get("hide_media_download_options").(bool) {
+		flags |= channelFlagHideMediaDownloadOptions
+	}
+
+	return flags
+}
+
+// buildAppliedTags returns the IDs of the tags configured on a forum post.
+func buildAppliedTags(d *schema.ResourceData) []string {
+	configured := d.Get("applied_tags").(*schema.Set).List()
+	tags := make([]string, 0, len(configured))
+	for _, t := range configured {
+		tags = append(tags, t.(string))
+	}
+
+	return tags
+}
+
+func setForumCreateData(d *schema.ResourceData, channelType string, data *channelCreateData) {
+	sortOrder := d.Get("default_sort_order").(int)
+
+	data.AvailableTags = buildForumTags(d, nil)
+	data.DefaultReactionEmoji = buildDefaultReaction(d)
+	data.DefaultSortOrder = &sortOrder
+	data.DefaultThreadRateLimitPerUser = d.Get("default_thread_rate_limit_per_user").(int)
+	if channelType == "forum" {
+		data.DefaultForumLayout = d.Get("default_forum_layout").(int)
+	}
+}
+
+func setForumEditData(d *schema.ResourceData, channelType string, channel *apiChannel, edit *channelEditData) {
+	if d.HasChange("available_tag") {
+		tags := buildForumTags(d, channel.AvailableTags)
+		edit.AvailableTags = &tags
+	}
+	if d.HasChange("default_reaction_emoji") {
+		edit.DefaultReactionEmoji = defaultReactionJSON(buildDefaultReaction(d))
+	}
+	if d.HasChange("default_sort_order") {
+		sortOrder := discordgo.ForumSortOrderType(d.Get("default_sort_order").(int))
+		edit.DefaultSortOrder = &sortOrder
+	}
+	if d.HasChange("default_thread_rate_limit_per_user") {
+		rateLimit := d.Get("default_thread_rate_limit_per_user").(int)
+		edit.DefaultThreadRateLimitPerUser = &rateLimit
+	}
+	if channelType == "forum" && d.HasChange("default_forum_layout") {
+		layout := discordgo.ForumLayout(d.Get("default_forum_layout").(int))
+		edit.DefaultForumLayout = &layout
+	}
+	if flags := forumChannelFlags(d, channelType, channel.Flags); flags != channel.Flags {
+		edit.Flags = &flags
+	}
+}
+
+func readForumChannel(d *schema.ResourceData, channelType string, channel *apiChannel) {
+	d.Set("available_tag", flattenForumTags(channel.AvailableTags))
+	d.Set("default_reaction_emoji", flattenDefaultReaction(channel.DefaultReactionEmoji))
+	// Discord doesn't set a sort order until one is chosen.
+	if channel.DefaultSortOrder == nil {
+		d.Set("default_sort_order", 0)
+	} else {
+		d.Set("default_sort_order", int(*channel.DefaultSortOrder))
+	}
+	d.Set("default_thread_rate_limit_per_user", channel.DefaultThreadRateLimitPerUser)
+	d.Set("require_tag", channel.Flags&discordgo.ChannelFlagRequireTag != 0)
+
+	switch channelType {
+	case "forum":
+		d.Set("default_forum_layout", int(channel.DefaultForumLayout))
+	case "media":
+		d.Set("hide_media_download_options", channel.Flags&channelFlagHideMediaDownloadOptions != 0)
+	}
+}
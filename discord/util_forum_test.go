@@ -0,0 +1,52 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBuildForumTags(t *testing.T) {
+	current := []discordgo.ForumTag{
+		{ID: "1", Name: "art"},
+		{ID: "2", Name: "wip"},
+		{ID: "3", Name: "old"},
+	}
+
+	params := []struct {
+		names []string
+		ids   []string
+	}{
+		// unchanged
+		{names: []string{"art", "wip", "old"}, ids: []string{"1", "2", "3"}},
+		// reordered tags keep their IDs
+		{names: []string{"wip", "art"}, ids: []string{"2", "1"}},
+		// a renamed tag keeps the ID of the tag at its position
+		{names: []string{"illustration", "wip", "old"}, ids: []string{"1", "2", "3"}},
+		// a tag inserted before existing ones doesn't take their IDs
+		{names: []string{"new", "art", "wip", "old"}, ids: []string{"", "1", "2", "3"}},
+		// a new tag isn't given the ID of a removed tag elsewhere
+		{names: []string{"art", "old", "new"}, ids: []string{"1", "3", ""}},
+		{names: []string{"new", "art", "wip"}, ids: []string{"", "1", "2"}},
+	}
+
+	for _, p := range params {
+		configured := make([]interface{}, len(p.names))
+		for i, name := range p.names {
+			configured[i] = map[string]interface{}{"name": name}
+		}
+		d := schema.TestResourceDataRaw(t, forumChannelSchema(), map[string]interface{}{"available_tag": configured})
+
+		tags := buildForumTags(d, current)
+		if len(tags) != len(p.ids) {
+			t.Errorf("%v - tags Error: ex: %v, ac: %v", p.names, len(p.ids), len(tags))
+			continue
+		}
+		for i, tag := range tags {
+			if tag.ID != p.ids[i] {
+				t.Errorf("%v - tag %s ID Error: ex: %v, ac: %v", p.names, tag.Name, p.ids[i], tag.ID)
+			}
+		}
+	}
+}
@@ -1,8 +1,15 @@
 package discord
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/polds/imgbase64"
 )
 
 func parseTwoIds(id string) (string, string, error) {
@@ -33,3 +40,58 @@ func parseThreeIds(id string) (string, string, string, error) {
 func generateThreePartId(one string, two string, three string) string {
 	return fmt.Sprintf("%s:%s:%s", one, two, three)
 }
+
+// JSON error codes Discord returns when the requested object doesn't exist.
+// https://discord.com/developers/docs/topics/opcodes-and-status-codes#json-json-error-codes
+var discordNotFoundCodes = []int{
+	10003, // Unknown Channel
+	10004, // Unknown Guild
+	10006, // Unknown Invite
+	10007, // Unknown Member
+	10008, // Unknown Message
+	10009, // Unknown Permission Overwrite
+	10011, // Unknown Role
+	10013, // Unknown User
+	10015, // Unknown Webhook
+}
+
+// isDiscordNotFound reports whether err is a Discord API error saying the
+// requested object doesn't exist. Any other error, e.g. a missing permission
+// or an outage, is not treated as the object being gone.
+func isDiscordNotFound(err error) bool {
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) {
+		return false
+	}
+	if restErr.Message != nil && contains(discordNotFoundCodes, restErr.Message.Code) {
+		return true
+	}
+
+	return restErr.Response != nil && restErr.Response.StatusCode == http.StatusNotFound
+}
+
+// fetchImage downloads a remote image and returns it as the data URI Discord
+// expects. Unlike imgbase64.FromRemote, a failed download is returned as an
+// error instead of exiting the provider.
+func fetchImage(ctx context.Context, m interface{}, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := m.(*Context).HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status fetching %s: %s", url, resp.Status)
+	}
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return "", err
+	}
+
+	return imgbase64.FromBuffer(buf), nil
+}
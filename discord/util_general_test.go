@@ -0,0 +1,79 @@
+package discord
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestIsDiscordNotFound(t *testing.T) {
+	restError := func(status int, code int) error {
+		return &discordgo.RESTError{
+			Response: &http.Response{StatusCode: status},
+			Message:  &discordgo.APIErrorMessage{Code: code},
+		}
+	}
+
+	params := []struct {
+		name     string
+		err      error
+		notFound bool
+	}{
+		{name: "unknown channel", err: restError(http.StatusNotFound, 10003), notFound: true},
+		{name: "unknown role", err: restError(http.StatusNotFound, 10011), notFound: true},
+		{name: "unknown message", err: restError(http.StatusNotFound, 10008), notFound: true},
+		{name: "plain 404", err: restError(http.StatusNotFound, 0), notFound: true},
+		{name: "wrapped 404", err: fmt.Errorf("wrapped: %w", restError(http.StatusNotFound, 10003)), notFound: true},
+		{name: "missing access", err: restError(http.StatusForbidden, 50001), notFound: false},
+		{name: "server error", err: restError(http.StatusInternalServerError, 0), notFound: false},
+		{name: "network error", err: errors.New("connection reset"), notFound: false},
+		{name: "nil", err: nil, notFound: false},
+	}
+
+	for _, p := range params {
+		if notFound := isDiscordNotFound(p.err); p.notFound != notFound {
+			t.Errorf("%s - notFound Error: ex: %v, ac: %v", p.name, p.notFound, notFound)
+		}
+	}
+}
+
+func TestFetchImage(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\nimage")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/image.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(png)
+	}))
+	defer srv.Close()
+
+	params := []struct {
+		name  string
+		url   string
+		out   string
+		isErr bool
+	}{
+		{name: "image", url: srv.URL + "/image.png", out: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png)},
+		// A failed download must be an error rather than exit the provider.
+		{name: "not found", url: srv.URL + "/missing.png", isErr: true},
+		{name: "unreachable", url: "http://127.0.0.1:0/image.png", isErr: true},
+	}
+
+	m := &Context{HTTPClient: srv.Client()}
+	for _, p := range params {
+		out, err := fetchImage(context.Background(), m, p.url)
+		if p.isErr != (err != nil) {
+			t.Errorf("%s - isErr Error: ex: %v, ac: %v", p.name, p.isErr, err)
+		}
+		if p.out != out {
+			t.Errorf("%s - out Error: ex: %v, ac: %v", p.name, p.out, out)
+		}
+	}
+}
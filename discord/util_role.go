@@ -60,7 +60,7 @@ func findRoleById(array []*discordgo.Role, id string) *discordgo.Role {
 func reorderRoles(ctx context.Context, m interface{}, serverId string, role *discordgo.Role, position int) (bool, diag.Diagnostics) {
 	client := m.(*Context).Session
 
-	roles, err := client.GuildRoles(serverId, discordgo.WithContext(ctx))
+	roles, err := m.(*Context).getGuildRoles(ctx, serverId)
 	if err != nil {
 		return false, diag.Errorf("Failed to fetch roles: %s", err.Error())
 	}
@@ -78,8 +78,8 @@ func reorderRoles(ctx context.Context, m interface{}, serverId string, role *dis
 	return true, nil
 }
 
-func getRole(ctx context.Context, client *discordgo.Session, serverId string, roleId string) (*discordgo.Role, error) {
-	if roles, err := client.GuildRoles(serverId, discordgo.WithContext(ctx)); err != nil {
+func getRole(ctx context.Context, m interface{}, serverId string, roleId string) (*discordgo.Role, error) {
+	if roles, err := m.(*Context).getGuildRoles(ctx, serverId); err != nil {
 		return nil, err
 	} else {
 		return findRoleById(roles, roleId), nil
@@ -0,0 +1,49 @@
+package discord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serverIdSchema returns the schema of a resource's server_id, which falls
+// back to the provider's default server when it isn't set.
+func serverIdSchema(description string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    forceNew,
+		Description: description + " Defaults to the provider's `server_id`.",
+	}
+}
+
+// getServerId returns the server a resource belongs to, using the provider's
+// default server if the resource doesn't set its own.
+func getServerId(d *schema.ResourceData, m interface{}) (string, error) {
+	if v, ok := d.GetOk("server_id"); ok {
+		return v.(string), nil
+	}
+	if serverId := m.(*Context).Config.ServerID; serverId != "" {
+		return serverId, nil
+	}
+
+	return "", errors.New("server_id must be set on either the resource or the provider")
+}
+
+// resourceServerIdCustomizeDiff resolves an unset server_id to the provider's
+// default server during plan, so the plan shows which server will be used.
+func resourceServerIdCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.GetAttr("server_id").IsNull() {
+		return nil
+	}
+
+	serverId := m.(*Context).Config.ServerID
+	if serverId == "" || d.Get("server_id").(string) == serverId {
+		return nil
+	}
+
+	return d.SetNew("server_id", serverId)
+}
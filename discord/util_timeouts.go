@@ -0,0 +1,36 @@
+package discord
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// defaultTimeout leaves room for a few rate limited retries of every
+	// request a resource makes.
+	defaultTimeout = 5 * time.Minute
+	// defaultReadTimeout is shorter as reads only make a handful of requests.
+	defaultReadTimeout = 2 * time.Minute
+)
+
+// resourceTimeouts returns the timeouts of a resource whose create, update
+// and delete may each take up to the given duration.
+func resourceTimeouts(timeout time.Duration) *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(timeout),
+		Read:   schema.DefaultTimeout(defaultReadTimeout),
+		Update: schema.DefaultTimeout(timeout),
+		Delete: schema.DefaultTimeout(timeout),
+	}
+}
+
+// withTimeouts gives a resource the default timeouts unless it declares its
+// own. The SDK applies them to the context passed to every discordgo request.
+func withTimeouts(r *schema.Resource) *schema.Resource {
+	if r.Timeouts == nil {
+		r.Timeouts = resourceTimeouts(defaultTimeout)
+	}
+
+	return r
+}
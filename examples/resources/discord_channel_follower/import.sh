@@ -0,0 +1 @@
+terraform import discord_channel_follower.example "<webhook id>"
@@ -0,0 +1,4 @@
+resource "discord_channel_follower" "announcements" {
+  channel_id        = var.announcements_channel_id
+  target_channel_id = discord_text_channel.partner_news.id
+}
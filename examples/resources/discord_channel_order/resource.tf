@@ -0,0 +1,37 @@
+resource "discord_category_channel" "chat" {
+  name      = "Chat"
+  server_id = var.server_id
+
+  # The order is managed by discord_channel_order.
+  lifecycle {
+    ignore_changes = [position]
+  }
+}
+
+resource "discord_text_channel" "general" {
+  name                     = "general"
+  server_id                = var.server_id
+  sync_perms_with_category = false
+
+  # The order is managed by discord_channel_order.
+  lifecycle {
+    ignore_changes = [position, category]
+  }
+}
+
+resource "discord_channel_order" "example" {
+  server_id = var.server_id
+
+  category {
+    category_id = discord_category_channel.chat.id
+    channel_ids = [
+      discord_text_channel.general.id,
+      discord_text_channel.off_topic.id,
+    ]
+  }
+
+  category {
+    category_id = discord_category_channel.staff.id
+    channel_ids = [discord_text_channel.staff.id]
+  }
+}
@@ -0,0 +1,21 @@
+data "discord_permission" "view" {
+  view_channel = "allow"
+}
+
+resource "discord_channel_permissions" "staff" {
+  channel_id = discord_text_channel.staff.id
+
+  # Hide the channel from everyone...
+  permission_overwrite {
+    type         = "role"
+    overwrite_id = var.server_id
+    deny         = data.discord_permission.view.allow_bits
+  }
+
+  # ...but the staff.
+  permission_overwrite {
+    type         = "role"
+    overwrite_id = discord_role.staff.id
+    allow        = data.discord_permission.view.allow_bits
+  }
+}
@@ -0,0 +1,23 @@
+resource "discord_forum_channel" "support" {
+  name                               = "support"
+  server_id                          = var.server_id
+  topic                              = "Ask for help here. Search before posting."
+  default_sort_order                 = 1
+  default_forum_layout               = 1
+  default_thread_rate_limit_per_user = 30
+  require_tag                        = true
+
+  available_tag {
+    name       = "question"
+    emoji_name = "❓"
+  }
+
+  available_tag {
+    name      = "solved"
+    moderated = true
+  }
+
+  default_reaction_emoji {
+    emoji_name = "✅"
+  }
+}
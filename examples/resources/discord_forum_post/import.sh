@@ -0,0 +1 @@
+terraform import discord_forum_post.example "<channel id>:<post id>"
@@ -0,0 +1,21 @@
+resource "discord_forum_channel" "faq" {
+  name      = "faq"
+  server_id = var.server_id
+
+  available_tag {
+    name = "getting-started"
+  }
+}
+
+resource "discord_forum_post" "getting_started" {
+  channel_id   = discord_forum_channel.faq.id
+  name         = "How do I get started?"
+  content      = "Read the rules, then introduce yourself in #general."
+  applied_tags = [discord_forum_channel.faq.available_tag[0].id]
+  pinned       = true
+
+  embed {
+    title = "Getting started"
+    url   = "https://example.com/wiki/getting-started"
+  }
+}
@@ -0,0 +1 @@
+terraform import discord_media_channel.example "<channel id>"
@@ -0,0 +1,20 @@
+resource "discord_media_channel" "gallery" {
+  name                        = "gallery"
+  server_id                   = var.server_id
+  topic                       = "Share your own work and credit your references."
+  hide_media_download_options = true
+
+  available_tag {
+    name       = "illustration"
+    emoji_name = "🎨"
+  }
+
+  available_tag {
+    name      = "featured"
+    moderated = true
+  }
+
+  default_reaction_emoji {
+    emoji_name = "❤️"
+  }
+}
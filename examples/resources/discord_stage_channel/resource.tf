@@ -0,0 +1,7 @@
+resource "discord_stage_channel" "events" {
+  name       = "Events"
+  server_id  = var.server_id
+  category   = discord_category_channel.community.id
+  position   = 0
+  rtc_region = "rotterdam"
+}
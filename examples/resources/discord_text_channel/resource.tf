@@ -3,3 +3,9 @@ resource "discord_text_channel" "general" {
   server_id = var.server_id
   position  = 0
 }
+
+resource "discord_text_channel" "off_topic" {
+  name             = "off-topic"
+  server_id        = var.server_id
+  after_channel_id = discord_text_channel.general.id
+}
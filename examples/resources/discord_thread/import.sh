@@ -0,0 +1 @@
+terraform import discord_thread.example "<channel id>:<thread id>"
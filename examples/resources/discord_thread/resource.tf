@@ -0,0 +1,13 @@
+resource "discord_thread" "help" {
+  channel_id            = var.channel_id
+  name                  = "help"
+  auto_archive_duration = 10080
+  rate_limit_per_user   = 30
+}
+
+resource "discord_thread" "announcements_discussion" {
+  channel_id = var.channel_id
+  message_id = var.announcement_message_id
+  name       = "announcements discussion"
+  locked     = true
+}
@@ -0,0 +1,596 @@
+package fakediscord
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"net/http"
+)
+
+// Channel types with settings of their own.
+const (
+	channelTypeGuildVoice = 2
+	channelTypeGuildNews  = 5
+	channelTypeGuildStage = 13
+	channelTypeGuildForum = 15
+	channelTypeGuildMedia = 16
+)
+
+func (s *Server) routeChannels(mux *http.ServeMux) {
+	s.handle(mux, "GET /guilds/{guild}/channels", s.listChannels)
+	s.handle(mux, "POST /guilds/{guild}/channels", s.createChannel)
+	s.handle(mux, "PATCH /guilds/{guild}/channels", s.reorderChannels)
+
+	s.handle(mux, "GET /channels/{channel}", s.getChannel)
+	s.handle(mux, "PATCH /channels/{channel}", s.editChannel)
+	s.handle(mux, "DELETE /channels/{channel}", s.deleteChannel)
+	s.handle(mux, "PUT /channels/{channel}/permissions/{overwrite}", s.setPermission)
+	s.handle(mux, "DELETE /channels/{channel}/permissions/{overwrite}", s.deletePermission)
+
+	s.handle(mux, "POST /channels/{channel}/messages", s.createMessage)
+	s.handle(mux, "GET /channels/{channel}/messages/{message}", s.getMessage)
+	s.handle(mux, "PATCH /channels/{channel}/messages/{message}", s.editMessage)
+	s.handle(mux, "DELETE /channels/{channel}/messages/{message}", s.deleteMessage)
+	s.handle(mux, "PUT /channels/{channel}/pins/{message}", s.pinMessage)
+	s.handle(mux, "DELETE /channels/{channel}/pins/{message}", s.unpinMessage)
+
+	s.handle(mux, "POST /channels/{channel}/invites", s.createInvite)
+	s.handle(mux, "GET /invites/{code}", s.getInvite)
+	s.handle(mux, "DELETE /invites/{code}", s.deleteInvite)
+
+	s.handle(mux, "GET /channels/{channel}/webhooks", s.listWebhooks)
+	s.handle(mux, "POST /channels/{channel}/webhooks", s.createWebhook)
+	s.handle(mux, "GET /webhooks/{webhook}", s.getWebhook)
+	s.handle(mux, "PATCH /webhooks/{webhook}", s.editWebhook)
+	s.handle(mux, "DELETE /webhooks/{webhook}", s.deleteWebhook)
+	s.handle(mux, "POST /channels/{channel}/followers", s.followChannel)
+}
+
+// AddChannel creates a channel in a server and returns its ID.
+func (s *Server) AddChannel(guildID string, fields Object) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addChannel(guildID, fields)["id"].(string)
+}
+
+func (s *Server) addChannel(guildID string, fields Object) Object {
+	channel := merge(Object{
+		"id":                    s.newID(),
+		"type":                  0,
+		"guild_id":              guildID,
+		"name":                  "channel",
+		"position":              0,
+		"permission_overwrites": []interface{}{},
+		"parent_id":             nil,
+		"nsfw":                  false,
+		"flags":                 0,
+	}, fields)
+	switch intField(channel, "type") {
+	case channelTypeGuildVoice:
+		// Like Discord, video_quality_mode is left out until it is set.
+		channel = merge(Object{"bitrate": 64000, "user_limit": 0, "rtc_region": nil, "rate_limit_per_user": 0}, channel)
+	case channelTypeGuildStage:
+		channel = merge(Object{"bitrate": 64000, "user_limit": 0, "rtc_region": nil}, channel)
+	case channelTypeGuildForum, channelTypeGuildMedia:
+		channel = merge(Object{
+			"available_tags":                     []interface{}{},
+			"default_reaction_emoji":             nil,
+			"default_sort_order":                 nil,
+			"default_forum_layout":               0,
+			"default_thread_rate_limit_per_user": 0,
+		}, channel)
+	}
+	s.assignTagIDs(channel)
+	s.channels[channel["id"].(string)] = channel
+	s.messages[channel["id"].(string)] = map[string]Object{}
+
+	return channel
+}
+
+func (s *Server) removeChannel(id string) {
+	for threadID, c := range s.channels {
+		if isThread(c) && c["parent_id"] == id {
+			s.removeChannel(threadID)
+		}
+	}
+	delete(s.channels, id)
+	delete(s.messages, id)
+	for code, i := range s.invites {
+		if i["channel"].(Object)["id"] == id {
+			delete(s.invites, code)
+		}
+	}
+	for webhookID, wh := range s.webhooks {
+		if wh["channel_id"] == id {
+			delete(s.webhooks, webhookID)
+		}
+	}
+}
+
+func (s *Server) channel(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	channel, ok := s.channels[r.PathValue("channel")]
+	if !ok {
+		writeNotFound(w, codeUnknownChannel, "Channel")
+	}
+
+	return channel, ok
+}
+
+func (s *Server) listChannels(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+
+	channels := map[string]Object{}
+	for id, c := range s.channels {
+		// Threads are listed by endpoints of their own.
+		if c["guild_id"] == guild["id"] && !isThread(c) {
+			channels[id] = c
+		}
+	}
+
+	writeJSON(w, http.StatusOK, sortedByPosition(channels))
+}
+
+func (s *Server) createChannel(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	delete(body, "id")
+	if parentID := stringField(body, "parent_id"); parentID != "" {
+		if _, ok := s.channels[parentID]; !ok {
+			writeNotFound(w, codeUnknownChannel, "Channel")
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusCreated, s.addChannel(guild["id"].(string), body))
+}
+
+func (s *Server) reorderChannels(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+	var body []Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	for _, p := range body {
+		channel, ok := s.channels[stringField(p, "id")]
+		if !ok || channel["guild_id"] != guild["id"] {
+			writeNotFound(w, codeUnknownChannel, "Channel")
+			return
+		}
+		for _, key := range []string{"position", "parent_id"} {
+			if v, ok := p[key]; ok {
+				channel[key] = v
+			}
+		}
+		if p["lock_permissions"] == true {
+			if parent, ok := s.channels[stringField(channel, "parent_id")]; ok {
+				channel["permission_overwrites"] = copyObject(Object{"v": parent["permission_overwrites"]})["v"]
+			}
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getChannel(w http.ResponseWriter, r *http.Request) {
+	if channel, ok := s.channel(w, r); ok {
+		writeJSON(w, http.StatusOK, channel)
+	}
+}
+
+func (s *Server) editChannel(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	delete(body, "id")
+	delete(body, "guild_id")
+	if isThread(channel) {
+		s.editThread(w, channel, body)
+		return
+	}
+	if _, ok := body["type"]; ok && intField(body, "type") != intField(channel, "type") && !isConvertible(channel, body) {
+		writeError(w, http.StatusBadRequest, 50035, "Invalid Form Body: only text and news channels can be converted into each other")
+		return
+	}
+	merge(channel, body)
+	s.assignTagIDs(channel)
+
+	writeJSON(w, http.StatusOK, channel)
+}
+
+// isConvertible reports whether an edit converts a text channel into a news
+// channel or back, the only type changes Discord allows.
+func isConvertible(channel Object, body Object) bool {
+	from, to := intField(channel, "type"), intField(body, "type")
+	return (from == 0 && to == channelTypeGuildNews) || (from == channelTypeGuildNews && to == 0)
+}
+
+// assignTagIDs gives the forum tags of a channel that don't have an ID yet
+// one, like Discord does for the tags added by a request.
+func (s *Server) assignTagIDs(channel Object) {
+	for _, t := range listField(channel, "available_tags") {
+		if tag, ok := t.(Object); ok && (stringField(tag, "id") == "" || stringField(tag, "id") == "0") {
+			tag["id"] = s.newID()
+		}
+	}
+}
+
+func (s *Server) deleteChannel(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+
+	s.removeChannel(channel["id"].(string))
+
+	writeJSON(w, http.StatusOK, channel)
+}
+
+func (s *Server) setPermission(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	overwrite := merge(Object{"allow": "0", "deny": "0"}, body)
+	overwrite["id"] = r.PathValue("overwrite")
+	channel["permission_overwrites"] = append(withoutOverwrite(channel, overwrite["id"].(string)), overwrite)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deletePermission(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+
+	channel["permission_overwrites"] = withoutOverwrite(channel, r.PathValue("overwrite"))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func withoutOverwrite(channel Object, id string) []interface{} {
+	overwrites := make([]interface{}, 0)
+	list, _ := channel["permission_overwrites"].([]interface{})
+	for _, o := range list {
+		if o.(Object)["id"] != id {
+			overwrites = append(overwrites, o)
+		}
+	}
+
+	return overwrites
+}
+
+func (s *Server) createMessage(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	if stringField(body, "content") == "" && len(listField(body, "embeds")) == 0 {
+		writeError(w, http.StatusBadRequest, 50006, "Cannot send an empty message")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.addMessage(channel, body))
+}
+
+func (s *Server) addMessage(channel Object, body Object) Object {
+	message := merge(Object{
+		"id":               s.newID(),
+		"type":             0,
+		"channel_id":       channel["id"],
+		"guild_id":         channel["guild_id"],
+		"author":           s.user,
+		"content":          "",
+		"timestamp":        now(),
+		"edited_timestamp": nil,
+		"tts":              false,
+		"mention_everyone": false,
+		"mentions":         []interface{}{},
+		"mention_roles":    []interface{}{},
+		"attachments":      []interface{}{},
+		"embeds":           []interface{}{},
+		"pinned":           false,
+	}, pick(body, "content", "tts", "embeds", "components", "flags"))
+	s.messages[channel["id"].(string)][message["id"].(string)] = message
+
+	return message
+}
+
+func (s *Server) message(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return nil, false
+	}
+	message, ok := s.messages[channel["id"].(string)][r.PathValue("message")]
+	if !ok {
+		writeNotFound(w, codeUnknownMessage, "Message")
+	}
+
+	return message, ok
+}
+
+func (s *Server) getMessage(w http.ResponseWriter, r *http.Request) {
+	if message, ok := s.message(w, r); ok {
+		writeJSON(w, http.StatusOK, message)
+	}
+}
+
+func (s *Server) editMessage(w http.ResponseWriter, r *http.Request) {
+	message, ok := s.message(w, r)
+	if !ok {
+		return
+	}
+	if channel := s.channels[message["channel_id"].(string)]; isThread(channel) && channel["thread_metadata"].(Object)["archived"] == true {
+		writeError(w, http.StatusBadRequest, codeThreadArchived, "Thread is archived")
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	merge(message, pick(body, "content", "embeds", "components", "flags"))
+	message["edited_timestamp"] = now()
+
+	writeJSON(w, http.StatusOK, message)
+}
+
+func (s *Server) deleteMessage(w http.ResponseWriter, r *http.Request) {
+	message, ok := s.message(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.messages[message["channel_id"].(string)], message["id"].(string))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) pinMessage(w http.ResponseWriter, r *http.Request) {
+	if message, ok := s.message(w, r); ok {
+		message["pinned"] = true
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) unpinMessage(w http.ResponseWriter, r *http.Request) {
+	if message, ok := s.message(w, r); ok {
+		message["pinned"] = false
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) createInvite(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	invite := merge(Object{
+		"code":       s.newID()[10:],
+		"type":       0,
+		"channel":    Object{"id": channel["id"], "name": channel["name"], "type": channel["type"]},
+		"guild":      Object{"id": channel["guild_id"]},
+		"inviter":    s.user,
+		"uses":       0,
+		"max_uses":   0,
+		"max_age":    86400,
+		"temporary":  false,
+		"created_at": now(),
+	}, pick(body, "max_uses", "max_age", "temporary"))
+	s.invites[invite["code"].(string)] = invite
+
+	writeJSON(w, http.StatusOK, invite)
+}
+
+func (s *Server) invite(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	invite, ok := s.invites[r.PathValue("code")]
+	if !ok {
+		writeNotFound(w, codeUnknownInvite, "Invite")
+	}
+
+	return invite, ok
+}
+
+func (s *Server) getInvite(w http.ResponseWriter, r *http.Request) {
+	if invite, ok := s.invite(w, r); ok {
+		// Discord only returns the metadata of an invite to its channel's
+		// managers.
+		writeJSON(w, http.StatusOK, pick(invite, "code", "type", "channel", "guild", "inviter"))
+	}
+}
+
+func (s *Server) deleteInvite(w http.ResponseWriter, r *http.Request) {
+	if invite, ok := s.invite(w, r); ok {
+		delete(s.invites, invite["code"].(string))
+		writeJSON(w, http.StatusOK, invite)
+	}
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+
+	webhooks := make([]Object, 0)
+	for _, wh := range s.webhooks {
+		if wh["channel_id"] == channel["id"] {
+			webhooks = append(webhooks, wh)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, webhooks)
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	webhook := Object{
+		"id":             s.newID(),
+		"type":           1,
+		"guild_id":       channel["guild_id"],
+		"channel_id":     channel["id"],
+		"user":           s.user,
+		"name":           body["name"],
+		"avatar":         avatarHash(body["avatar"]),
+		"token":          "token-" + s.newID(),
+		"application_id": nil,
+	}
+	s.webhooks[webhook["id"].(string)] = webhook
+
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+// followChannel creates a webhook posting the messages published in a news
+// channel to another channel. Like Discord, the webhook has no token.
+func (s *Server) followChannel(w http.ResponseWriter, r *http.Request) {
+	source, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+	if intField(source, "type") != channelTypeGuildNews {
+		writeError(w, http.StatusBadRequest, 50024, "Cannot execute action on this channel type")
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	target, ok := s.channels[stringField(body, "webhook_channel_id")]
+	if !ok {
+		writeNotFound(w, codeUnknownChannel, "Channel")
+		return
+	}
+
+	guild := s.guilds[stringField(source, "guild_id")]
+	webhook := Object{
+		"id":             s.newID(),
+		"type":           2,
+		"guild_id":       target["guild_id"],
+		"channel_id":     target["id"],
+		"user":           s.user,
+		"name":           fmt.Sprintf("%s #%s", guild["name"], source["name"]),
+		"avatar":         nil,
+		"application_id": nil,
+		"source_guild":   Object{"id": guild["id"], "name": guild["name"], "icon": guild["icon"]},
+		"source_channel": Object{"id": source["id"], "name": source["name"]},
+	}
+	s.webhooks[webhook["id"].(string)] = webhook
+
+	writeJSON(w, http.StatusOK, Object{"channel_id": source["id"], "webhook_id": webhook["id"]})
+}
+
+func (s *Server) webhook(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	webhook, ok := s.webhooks[r.PathValue("webhook")]
+	if !ok {
+		writeNotFound(w, codeUnknownWebhook, "Webhook")
+	}
+
+	return webhook, ok
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, r *http.Request) {
+	if webhook, ok := s.webhook(w, r); ok {
+		writeJSON(w, http.StatusOK, webhook)
+	}
+}
+
+func (s *Server) editWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook, ok := s.webhook(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	if v, ok := body["avatar"]; ok {
+		webhook["avatar"] = avatarHash(v)
+	}
+	merge(webhook, pick(body, "name", "channel_id"))
+
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	if webhook, ok := s.webhook(w, r); ok {
+		delete(s.webhooks, webhook["id"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// avatarHash stands in for the hash Discord returns for an uploaded image.
+func avatarHash(dataURI interface{}) interface{} {
+	if uri, ok := dataURI.(string); ok && uri != "" {
+		return fmt.Sprintf("%x", sha1.Sum([]byte(uri)))
+	}
+
+	return nil
+}
+
+// pick returns the given fields of o that are set.
+func pick(o Object, keys ...string) Object {
+	res := Object{}
+	for _, k := range keys {
+		if v, ok := o[k]; ok {
+			res[k] = v
+		}
+	}
+
+	return res
+}
+
+func listField(o Object, key string) []interface{} {
+	l, _ := o[key].([]interface{})
+	return l
+}
@@ -0,0 +1,513 @@
+package fakediscord
+
+import (
+	"net/http"
+	"strings"
+)
+
+func (s *Server) routeGuilds(mux *http.ServeMux) {
+	s.handle(mux, "GET /users/@me", s.getCurrentUser)
+	s.handle(mux, "GET /users/@me/guilds", s.listCurrentUserGuilds)
+
+	s.handle(mux, "POST /guilds", s.createGuild)
+	s.handle(mux, "GET /guilds/{guild}", s.getGuild)
+	s.handle(mux, "PATCH /guilds/{guild}", s.editGuild)
+	s.handle(mux, "DELETE /guilds/{guild}", s.deleteGuild)
+
+	s.handle(mux, "GET /guilds/{guild}/roles", s.listRoles)
+	s.handle(mux, "POST /guilds/{guild}/roles", s.createRole)
+	s.handle(mux, "PATCH /guilds/{guild}/roles", s.reorderRoles)
+	s.handle(mux, "PATCH /guilds/{guild}/roles/{role}", s.editRole)
+	s.handle(mux, "DELETE /guilds/{guild}/roles/{role}", s.deleteRole)
+
+	s.handle(mux, "GET /guilds/{guild}/members", s.listMembers)
+	s.handle(mux, "GET /guilds/{guild}/members/search", s.searchMembers)
+	s.handle(mux, "GET /guilds/{guild}/members/{user}", s.getMember)
+	s.handle(mux, "PATCH /guilds/{guild}/members/{user}", s.editMember)
+	s.handle(mux, "PUT /guilds/{guild}/members/{user}/roles/{role}", s.addMemberRole)
+	s.handle(mux, "DELETE /guilds/{guild}/members/{user}/roles/{role}", s.removeMemberRole)
+
+	s.handle(mux, "GET /guilds/{guild}/onboarding", s.getOnboarding)
+	s.handle(mux, "PUT /guilds/{guild}/onboarding", s.editOnboarding)
+}
+
+// AddGuild creates a server owned by the bot, with the given fields set, and
+// returns its ID.
+func (s *Server) AddGuild(fields Object) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addGuild(fields)["id"].(string)
+}
+
+// EditGuild sets fields of an existing server.
+func (s *Server) EditGuild(guildID string, fields Object) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	merge(s.guilds[guildID], fields)
+}
+
+// AddRole creates a role in a server and returns its ID.
+func (s *Server) AddRole(guildID string, fields Object) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addRole(guildID, fields)["id"].(string)
+}
+
+// AddMember adds a new user with the given fields to a server and returns the
+// user's ID. Members can't be added through the API.
+func (s *Server) AddMember(guildID string, user Object, roles []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addMember(guildID, s.addUser(user), roles)["user"].(Object)["id"].(string)
+}
+
+func (s *Server) addGuild(fields Object) Object {
+	id := s.newID()
+	guild := merge(Object{
+		"id":                            id,
+		"name":                          "server",
+		"icon":                          nil,
+		"splash":                        nil,
+		"discovery_splash":              nil,
+		"banner":                        nil,
+		"description":                   nil,
+		"owner_id":                      s.user["id"],
+		"region":                        "us-west",
+		"afk_channel_id":                nil,
+		"afk_timeout":                   300,
+		"verification_level":            0,
+		"default_message_notifications": 0,
+		"explicit_content_filter":       0,
+		"features":                      []interface{}{},
+		"mfa_level":                     0,
+		"system_channel_id":             nil,
+		"system_channel_flags":          0,
+		"rules_channel_id":              nil,
+		"public_updates_channel_id":     nil,
+		"preferred_locale":              "en-US",
+		"premium_tier":                  0,
+		"nsfw_level":                    0,
+	}, fields)
+	s.guilds[id] = guild
+	s.roles[id] = map[string]Object{}
+	s.members[id] = map[string]Object{}
+
+	// The @everyone role shares the ID of its server.
+	s.roles[id][id] = s.newRole(Object{"id": id, "name": "@everyone", "position": 0, "permissions": "1071698660929"})
+	s.addMember(id, s.user, nil)
+
+	return guild
+}
+
+func (s *Server) newRole(fields Object) Object {
+	return merge(Object{
+		"id":            s.newID(),
+		"name":          "new role",
+		"color":         0,
+		"hoist":         false,
+		"icon":          nil,
+		"unicode_emoji": nil,
+		"position":      1,
+		"permissions":   "0",
+		"managed":       false,
+		"mentionable":   false,
+		"flags":         0,
+	}, fields)
+}
+
+// addRole creates a role above every existing one. Discord inserts new roles
+// at the bottom instead, which would shift the position of existing roles
+// under tests running in parallel.
+func (s *Server) addRole(guildID string, fields Object) Object {
+	position := 0
+	for _, r := range s.roles[guildID] {
+		if p := intField(r, "position"); p > position {
+			position = p
+		}
+	}
+
+	role := s.newRole(merge(Object{"position": position + 1}, fields))
+	s.roles[guildID][role["id"].(string)] = role
+
+	return role
+}
+
+func (s *Server) addMember(guildID string, user Object, roles []string) Object {
+	if roles == nil {
+		roles = []string{}
+	}
+	member := Object{
+		"user":          user,
+		"nick":          nil,
+		"avatar":        nil,
+		"roles":         roles,
+		"joined_at":     now(),
+		"premium_since": nil,
+		"deaf":          false,
+		"mute":          false,
+		"flags":         0,
+		"pending":       false,
+	}
+	s.members[guildID][user["id"].(string)] = member
+
+	return member
+}
+
+// guild returns the server a request refers to, or writes an error if it
+// doesn't exist.
+func (s *Server) guild(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	guild, ok := s.guilds[r.PathValue("guild")]
+	if !ok {
+		writeNotFound(w, codeUnknownGuild, "Guild")
+	}
+
+	return guild, ok
+}
+
+func (s *Server) guildResponse(guild Object) Object {
+	res := copyObject(guild)
+	res["roles"] = sortedByPosition(s.roles[guild["id"].(string)])
+
+	return res
+}
+
+func (s *Server) getCurrentUser(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.user)
+}
+
+func (s *Server) listCurrentUserGuilds(w http.ResponseWriter, r *http.Request) {
+	guilds := make([]Object, 0, len(s.guilds))
+	for _, g := range s.guilds {
+		guilds = append(guilds, Object{
+			"id":          g["id"],
+			"name":        g["name"],
+			"icon":        g["icon"],
+			"owner":       g["owner_id"] == s.user["id"],
+			"permissions": "2199023255551",
+			"features":    g["features"],
+		})
+	}
+
+	writeJSON(w, http.StatusOK, guilds)
+}
+
+func (s *Server) createGuild(w http.ResponseWriter, r *http.Request) {
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, s.guildResponse(s.addGuild(body)))
+}
+
+func (s *Server) getGuild(w http.ResponseWriter, r *http.Request) {
+	if guild, ok := s.guild(w, r); ok {
+		writeJSON(w, http.StatusOK, s.guildResponse(guild))
+	}
+}
+
+func (s *Server) editGuild(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.guildResponse(merge(guild, body)))
+}
+
+func (s *Server) deleteGuild(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+
+	id := guild["id"].(string)
+	for channelID, c := range s.channels {
+		if c["guild_id"] == id {
+			s.removeChannel(channelID)
+		}
+	}
+	delete(s.guilds, id)
+	delete(s.roles, id)
+	delete(s.members, id)
+	delete(s.onboarding, id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listRoles(w http.ResponseWriter, r *http.Request) {
+	if guild, ok := s.guild(w, r); ok {
+		writeJSON(w, http.StatusOK, sortedByPosition(s.roles[guild["id"].(string)]))
+	}
+}
+
+func (s *Server) createRole(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	delete(body, "id")
+	delete(body, "position")
+
+	writeJSON(w, http.StatusOK, s.addRole(guild["id"].(string), body))
+}
+
+func (s *Server) reorderRoles(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+	var body []Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	roles := s.roles[guild["id"].(string)]
+	for _, p := range body {
+		role, ok := roles[stringField(p, "id")]
+		if !ok {
+			writeNotFound(w, codeUnknownRole, "Role")
+			return
+		}
+		role["position"] = p["position"]
+	}
+
+	writeJSON(w, http.StatusOK, sortedByPosition(roles))
+}
+
+func (s *Server) role(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return nil, false
+	}
+	role, ok := s.roles[guild["id"].(string)][r.PathValue("role")]
+	if !ok {
+		writeNotFound(w, codeUnknownRole, "Role")
+	}
+
+	return role, ok
+}
+
+func (s *Server) editRole(w http.ResponseWriter, r *http.Request) {
+	role, ok := s.role(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	delete(body, "id")
+
+	writeJSON(w, http.StatusOK, merge(role, body))
+}
+
+func (s *Server) deleteRole(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.role(w, r); !ok {
+		return
+	}
+
+	guildID, roleID := r.PathValue("guild"), r.PathValue("role")
+	delete(s.roles[guildID], roleID)
+	for _, m := range s.members[guildID] {
+		m["roles"] = without(m["roles"], roleID)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listMembers(w http.ResponseWriter, r *http.Request) {
+	if guild, ok := s.guild(w, r); ok {
+		writeJSON(w, http.StatusOK, s.filterMembers(guild, func(Object) bool { return true }))
+	}
+}
+
+func (s *Server) searchMembers(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+
+	query := strings.ToLower(r.URL.Query().Get("query"))
+	writeJSON(w, http.StatusOK, s.filterMembers(guild, func(m Object) bool {
+		user := m["user"].(Object)
+		return strings.HasPrefix(strings.ToLower(stringField(user, "username")), query) ||
+			strings.HasPrefix(strings.ToLower(stringField(m, "nick")), query)
+	}))
+}
+
+func (s *Server) filterMembers(guild Object, match func(Object) bool) []Object {
+	members := make([]Object, 0)
+	for _, m := range s.members[guild["id"].(string)] {
+		if match(m) {
+			members = append(members, copyObject(m))
+		}
+	}
+
+	return members
+}
+
+func (s *Server) member(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return nil, false
+	}
+	member, ok := s.members[guild["id"].(string)][r.PathValue("user")]
+	if !ok {
+		writeNotFound(w, codeUnknownMember, "Member")
+	}
+
+	return member, ok
+}
+
+func (s *Server) getMember(w http.ResponseWriter, r *http.Request) {
+	if member, ok := s.member(w, r); ok {
+		writeJSON(w, http.StatusOK, member)
+	}
+}
+
+func (s *Server) editMember(w http.ResponseWriter, r *http.Request) {
+	member, ok := s.member(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	delete(body, "user")
+
+	writeJSON(w, http.StatusOK, merge(member, body))
+}
+
+func (s *Server) addMemberRole(w http.ResponseWriter, r *http.Request) {
+	member, ok := s.member(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := s.role(w, r); !ok {
+		return
+	}
+
+	roleID := r.PathValue("role")
+	member["roles"] = append(without(member["roles"], roleID), roleID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) removeMemberRole(w http.ResponseWriter, r *http.Request) {
+	member, ok := s.member(w, r)
+	if !ok {
+		return
+	}
+
+	member["roles"] = without(member["roles"], r.PathValue("role"))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// without returns the IDs in a JSON list, except for id.
+func without(list interface{}, id string) []interface{} {
+	ids := make([]interface{}, 0)
+	switch l := list.(type) {
+	case []interface{}:
+		for _, v := range l {
+			if v != id {
+				ids = append(ids, v)
+			}
+		}
+	case []string:
+		for _, v := range l {
+			if v != id {
+				ids = append(ids, v)
+			}
+		}
+	}
+
+	return ids
+}
+
+func (s *Server) getOnboarding(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+
+	id := guild["id"].(string)
+	onboarding, ok := s.onboarding[id]
+	if !ok {
+		onboarding = Object{
+			"guild_id":            id,
+			"prompts":             []interface{}{},
+			"default_channel_ids": []interface{}{},
+			"enabled":             false,
+			"mode":                0,
+		}
+	}
+
+	writeJSON(w, http.StatusOK, onboarding)
+}
+
+func (s *Server) editOnboarding(w http.ResponseWriter, r *http.Request) {
+	guild, ok := s.guild(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	id := guild["id"].(string)
+	onboarding, ok := s.onboarding[id]
+	if !ok {
+		onboarding = Object{"guild_id": id, "prompts": []interface{}{}, "default_channel_ids": []interface{}{}, "enabled": false, "mode": 0}
+		s.onboarding[id] = onboarding
+	}
+	merge(onboarding, body)
+	onboarding["guild_id"] = id
+
+	// Discord assigns IDs to new prompts and options, and returns the emoji
+	// of an option as an object.
+	prompts, _ := onboarding["prompts"].([]interface{})
+	for _, p := range prompts {
+		prompt := p.(Object)
+		if id := stringField(prompt, "id"); id == "" || id == "0" {
+			prompt["id"] = s.newID()
+		}
+		options, _ := prompt["options"].([]interface{})
+		for _, o := range options {
+			option := o.(Object)
+			if id := stringField(option, "id"); id == "" || id == "0" {
+				option["id"] = s.newID()
+			}
+			if _, ok := option["emoji_name"]; ok {
+				option["emoji"] = Object{"id": option["emoji_id"], "name": option["emoji_name"], "animated": option["emoji_animated"] == true}
+			}
+			delete(option, "emoji_id")
+			delete(option, "emoji_name")
+			delete(option, "emoji_animated")
+		}
+	}
+
+	writeJSON(w, http.StatusOK, onboarding)
+}
@@ -0,0 +1,241 @@
+// Package fakediscord implements an in-memory stand-in for the parts of the
+// Discord REST API used by the provider, so that its acceptance tests can run
+// without network access or a real Discord server.
+//
+// Objects are stored as the JSON documents Discord would return: create and
+// update requests are merged into them field by field, so fields the fake
+// doesn't know about round-trip as sent.
+package fakediscord
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Object is a JSON object as sent to or returned by the API.
+type Object = map[string]interface{}
+
+// Server is an in-memory Discord REST API.
+type Server struct {
+	// URL is the base URL of the API, to be used as the provider's api_url.
+	URL string
+	// ImageURL serves a PNG image, for attributes that take the URL of one.
+	ImageURL string
+
+	srv *httptest.Server
+
+	mu     sync.Mutex
+	nextID uint64
+	user   Object
+
+	users      map[string]Object
+	guilds     map[string]Object
+	roles      map[string]map[string]Object
+	members    map[string]map[string]Object
+	onboarding map[string]Object
+	channels   map[string]Object
+	messages   map[string]map[string]Object
+	invites    map[string]Object
+	webhooks   map[string]Object
+}
+
+// NewServer starts a fake API. The bot it authenticates as owns every server
+// created through it.
+func NewServer() *Server {
+	s := &Server{
+		nextID:     1100000000000000000,
+		users:      map[string]Object{},
+		guilds:     map[string]Object{},
+		roles:      map[string]map[string]Object{},
+		members:    map[string]map[string]Object{},
+		onboarding: map[string]Object{},
+		channels:   map[string]Object{},
+		messages:   map[string]map[string]Object{},
+		invites:    map[string]Object{},
+		webhooks:   map[string]Object{},
+	}
+	s.user = s.addUser(Object{"username": "terraform", "bot": true})
+
+	mux := http.NewServeMux()
+	s.routeGuilds(mux)
+	s.routeChannels(mux)
+	s.routeThreads(mux)
+	mux.HandleFunc("GET "+imagePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(image)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, 0, "404: Not Found")
+	})
+
+	s.srv = httptest.NewServer(s.authenticate(mux))
+	s.URL = s.srv.URL + apiPrefix + "/"
+	s.ImageURL = s.srv.URL + imagePath
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+const (
+	apiPrefix = "/api/v9"
+	imagePath = "/images/avatar.png"
+)
+
+// image is a 1x1 transparent PNG.
+var image, _ = base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==")
+
+// Discord error codes returned for unknown objects.
+const (
+	codeUnknownChannel = 10003
+	codeUnknownGuild   = 10004
+	codeUnknownInvite  = 10006
+	codeUnknownMember  = 10007
+	codeUnknownMessage = 10008
+	codeUnknownRole    = 10011
+	codeUnknownWebhook = 10015
+)
+
+// authenticate rejects API requests without credentials, like Discord does.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, apiPrefix+"/") && r.Header.Get("Authorization") == "" {
+			writeError(w, http.StatusUnauthorized, 0, "401: Unauthorized")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handle registers a handler that runs with the server's state locked.
+func (s *Server) handle(mux *http.ServeMux, pattern string, h func(w http.ResponseWriter, r *http.Request)) {
+	method, path, _ := strings.Cut(pattern, " ")
+	mux.HandleFunc(method+" "+apiPrefix+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		h(w, r)
+	})
+}
+
+func (s *Server) newID() string {
+	s.nextID++
+	return strconv.FormatUint(s.nextID, 10)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+func (s *Server) addUser(fields Object) Object {
+	user := merge(Object{
+		"id":            s.newID(),
+		"username":      "user",
+		"discriminator": "0",
+		"global_name":   nil,
+		"avatar":        nil,
+		"bot":           false,
+	}, fields)
+	s.users[user["id"].(string)] = user
+
+	return user
+}
+
+// readBody decodes a JSON request body, keeping numbers as they were sent.
+func readBody(r *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code int, message string) {
+	writeJSON(w, status, Object{"code": code, "message": message})
+}
+
+func writeNotFound(w http.ResponseWriter, code int, kind string) {
+	writeError(w, http.StatusNotFound, code, fmt.Sprintf("Unknown %s", kind))
+}
+
+func writeBadRequest(w http.ResponseWriter, err error) {
+	writeError(w, http.StatusBadRequest, 50035, fmt.Sprintf("Invalid Form Body: %s", err))
+}
+
+// merge copies every field of src into dst. A null value clears the field,
+// as it does in Discord's PATCH requests.
+func merge(dst Object, src Object) Object {
+	for k, v := range src {
+		dst[k] = v
+	}
+
+	return dst
+}
+
+// copyObject returns a deep copy of o, so responses don't share state.
+func copyObject(o Object) Object {
+	b, _ := json.Marshal(o)
+	var c Object
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	decoder.Decode(&c)
+
+	return c
+}
+
+// sortedByPosition returns the objects ordered by their position, then ID.
+func sortedByPosition(objects map[string]Object) []Object {
+	list := make([]Object, 0, len(objects))
+	for _, o := range objects {
+		list = append(list, copyObject(o))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		pi, pj := intField(list[i], "position"), intField(list[j], "position")
+		if pi != pj {
+			return pi < pj
+		}
+		return list[i]["id"].(string) < list[j]["id"].(string)
+	})
+
+	return list
+}
+
+func intField(o Object, key string) int {
+	switch v := o[key].(type) {
+	case json.Number:
+		i, _ := v.Int64()
+		return int(i)
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+
+	return 0
+}
+
+func stringField(o Object, key string) string {
+	if v, ok := o[key].(string); ok {
+		return v
+	}
+
+	return ""
+}
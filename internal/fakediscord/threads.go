@@ -0,0 +1,175 @@
+package fakediscord
+
+import (
+	"net/http"
+)
+
+// Thread types.
+const (
+	channelTypeGuildNewsThread    = 10
+	channelTypeGuildPublicThread  = 11
+	channelTypeGuildPrivateThread = 12
+)
+
+// Discord error codes for threads.
+const (
+	codeThreadArchived = 50083
+)
+
+// threadMetadataFields are the fields of a thread that are edited at the top
+// level of a request but returned in its thread_metadata.
+var threadMetadataFields = []string{"archived", "auto_archive_duration", "locked", "invitable"}
+
+func (s *Server) routeThreads(mux *http.ServeMux) {
+	s.handle(mux, "POST /channels/{channel}/threads", s.startThread)
+	s.handle(mux, "POST /channels/{channel}/messages/{message}/threads", s.startMessageThread)
+}
+
+func isThread(channel Object) bool {
+	switch intField(channel, "type") {
+	case channelTypeGuildNewsThread, channelTypeGuildPublicThread, channelTypeGuildPrivateThread:
+		return true
+	}
+
+	return false
+}
+
+// addThread creates a thread in a channel. Threads started from a message
+// share its ID, like they do in Discord.
+func (s *Server) addThread(parent Object, id string, threadType int, body Object) Object {
+	autoArchiveDuration := intField(parent, "default_auto_archive_duration")
+	if v := intField(body, "auto_archive_duration"); v != 0 {
+		autoArchiveDuration = v
+	}
+	if autoArchiveDuration == 0 {
+		autoArchiveDuration = 1440
+	}
+
+	metadata := Object{
+		"archived":              false,
+		"auto_archive_duration": autoArchiveDuration,
+		"archive_timestamp":     now(),
+		"locked":                false,
+	}
+	if threadType == channelTypeGuildPrivateThread {
+		metadata["invitable"] = true
+		if v, ok := body["invitable"]; ok {
+			metadata["invitable"] = v
+		}
+	}
+
+	thread := merge(Object{
+		"id":                  id,
+		"type":                threadType,
+		"guild_id":            parent["guild_id"],
+		"parent_id":           parent["id"],
+		"owner_id":            s.user["id"],
+		"name":                "thread",
+		"flags":               0,
+		"rate_limit_per_user": 0,
+		"message_count":       0,
+		"member_count":        1,
+		"thread_metadata":     metadata,
+	}, pick(body, "name", "rate_limit_per_user", "applied_tags"))
+	s.channels[id] = thread
+	s.messages[id] = map[string]Object{}
+
+	return thread
+}
+
+func (s *Server) startThread(w http.ResponseWriter, r *http.Request) {
+	parent, ok := s.channel(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+
+	if parentType := intField(parent, "type"); parentType == channelTypeGuildForum || parentType == channelTypeGuildMedia {
+		s.startForumPost(w, parent, body)
+		return
+	}
+
+	// Threads not started from a message are private unless asked otherwise.
+	threadType := channelTypeGuildPrivateThread
+	if body["type"] != nil {
+		threadType = intField(body, "type")
+	}
+
+	writeJSON(w, http.StatusCreated, s.addThread(parent, s.newID(), threadType, body))
+}
+
+// startForumPost creates a post in a forum or media channel: a public thread
+// whose starter message shares its ID.
+func (s *Server) startForumPost(w http.ResponseWriter, parent Object, body Object) {
+	starter, ok := body["message"].(Object)
+	if !ok || (stringField(starter, "content") == "" && len(listField(starter, "embeds")) == 0) {
+		writeError(w, http.StatusBadRequest, 50006, "Cannot send an empty message")
+		return
+	}
+
+	id := s.newID()
+	thread := s.addThread(parent, id, channelTypeGuildPublicThread, body)
+	message := s.addMessage(thread, starter)
+	delete(s.messages[id], message["id"].(string))
+	message["id"] = id
+	s.messages[id][id] = message
+
+	post := copyObject(thread)
+	post["message"] = message
+	writeJSON(w, http.StatusCreated, post)
+}
+
+func (s *Server) startMessageThread(w http.ResponseWriter, r *http.Request) {
+	message, ok := s.message(w, r)
+	if !ok {
+		return
+	}
+	var body Object
+	if err := readBody(r, &body); err != nil {
+		writeBadRequest(w, err)
+		return
+	}
+	if _, ok := s.channels[message["id"].(string)]; ok {
+		writeError(w, http.StatusBadRequest, 160004, "A thread has already been created for this message")
+		return
+	}
+
+	parent := s.channels[message["channel_id"].(string)]
+	threadType := channelTypeGuildPublicThread
+	if intField(parent, "type") == channelTypeGuildNews {
+		threadType = channelTypeGuildNewsThread
+	}
+
+	writeJSON(w, http.StatusCreated, s.addThread(parent, message["id"].(string), threadType, body))
+}
+
+// editThread applies an edit to a thread. Like Discord, an archived thread
+// can only be edited by a request that unarchives it.
+func (s *Server) editThread(w http.ResponseWriter, thread Object, body Object) {
+	metadata := thread["thread_metadata"].(Object)
+	if metadata["archived"] == true && body["archived"] != false {
+		writeError(w, http.StatusBadRequest, codeThreadArchived, "Thread is archived")
+		return
+	}
+
+	for _, key := range threadMetadataFields {
+		if v, ok := body[key]; ok {
+			if key == "invitable" && intField(thread, "type") != channelTypeGuildPrivateThread {
+				delete(body, key)
+				continue
+			}
+			metadata[key] = v
+			delete(body, key)
+			if key == "archived" {
+				metadata["archive_timestamp"] = now()
+			}
+		}
+	}
+	merge(thread, pick(body, "name", "rate_limit_per_user", "applied_tags", "flags"))
+
+	writeJSON(w, http.StatusOK, thread)
+}
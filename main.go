@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 
 	"github.com/lucky3028/discord-terraform/discord"
 )
@@ -21,8 +23,18 @@ func main() {
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: discord.Provider(version),
-		Debug:        debugMode,
-	})
+
+	providerServer, err := discord.ProtoV5ProviderServerFactory(context.Background(), version)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	if err := tf5server.Serve("registry.terraform.io/lucky3028/discord", providerServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }